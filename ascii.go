@@ -0,0 +1,98 @@
+package hello
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ASCIIPlaceholder replaces each character SayHelloASCII cannot
+// transliterate.
+const ASCIIPlaceholder = "?"
+
+// translit holds transliterations of lowercase characters that do not
+// reduce to ASCII by removing diacritics; transliterate handles capitals.
+// Cyrillic follows a simplified BGN/PCGN romanization.
+var translit = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'þ': "th", 'ł': "l", 'ı': "i",
+
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// SayHelloASCII greets name transliterated to plain ASCII, for systems
+// that cannot display anything else. Diacritics are removed ("José"
+// becomes "Jose"), letters such as "ß" and Cyrillic are spelled out from
+// a built-in table ("Пётр" becomes "Pyotr"), and every other non-ASCII
+// character, including all CJK ideographs, becomes ASCIIPlaceholder.
+func SayHelloASCII(name string) string {
+	var b strings.Builder
+	runes := []rune(norm.NFC.String(name))
+	for i, r := range runes {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		if unicode.Is(unicode.Mn, r) {
+			// A mark NFC could not compose into its base letter.
+			continue
+		}
+		if s, ok := transliterate(runes, i); ok {
+			b.WriteString(s)
+			continue
+		}
+		b.WriteString(stripMarks(r))
+	}
+	return SayHello(b.String())
+}
+
+// transliterate looks runes[i] up in translit. A capital letter has its
+// spelling capitalized, or written entirely in capitals when the letter
+// beside it is also a capital, so "Жанна" becomes "Zhanna" and "ЖАННА"
+// becomes "ZHANNA".
+func transliterate(runes []rune, i int) (string, bool) {
+	r := runes[i]
+	lower := unicode.ToLower(r)
+	s, ok := translit[lower]
+	if !ok || lower == r || s == "" {
+		return s, ok
+	}
+	if inCapitals(runes, i) {
+		return strings.ToUpper(s), true
+	}
+	return strings.ToUpper(s[:1]) + s[1:], true
+}
+
+// inCapitals reports whether the letter after runes[i], or failing that
+// the letter before it, is uppercase.
+func inCapitals(runes []rune, i int) bool {
+	for _, j := range [...]int{i + 1, i - 1} {
+		if j >= 0 && j < len(runes) && unicode.IsLetter(runes[j]) {
+			return unicode.IsUpper(runes[j])
+		}
+	}
+	return false
+}
+
+// stripMarks returns the ASCII letters left after decomposing r and
+// dropping its combining marks, or ASCIIPlaceholder if nothing is left.
+func stripMarks(r rune) string {
+	var ascii []byte
+	for _, d := range norm.NFKD.String(string(r)) {
+		switch {
+		case d < utf8.RuneSelf:
+			ascii = append(ascii, byte(d))
+		case !unicode.Is(unicode.Mn, d):
+			return ASCIIPlaceholder
+		}
+	}
+	if len(ascii) == 0 {
+		return ASCIIPlaceholder
+	}
+	return string(ascii)
+}
@@ -0,0 +1,34 @@
+package hello
+
+import "testing"
+
+func TestSayHelloASCII(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"World", "Hello World!"},
+		{"José", "Hello Jose!"},
+		{"Jose\u0301", "Hello Jose!"},
+		{"Ade\u0301ba\u0301yo\u0323\u0300", "Hello Adebayo!"},
+		{"q\u0301", "Hello q!"},
+		{"a\u0328\u0303", "Hello a!"},
+		{"Renée Zoë Ångström", "Hello Renee Zoe Angstrom!"},
+		{"Łukasz Straße", "Hello Lukasz Strasse!"},
+		{"Søren Ærø", "Hello Soren Aero!"},
+		{"Пётр Чайковский", "Hello Pyotr Chaykovskiy!"},
+		{"Юлия", "Hello Yuliya!"},
+		{"ЖАННА", "Hello ZHANNA!"},
+		{"Жанна", "Hello Zhanna!"},
+		{"ЩУКИН ТИЩ", "Hello SHCHUKIN TISHCH!"},
+		{"Ж", "Hello Zh!"},
+		{"ÆBLE Æble", "Hello AEBLE Aeble!"},
+		{"嗨", "Hello ?!"},
+		{"王伟", "Hello ??!"},
+		{"Ada 👋", "Hello Ada ?!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloASCII(tt.name); got != tt.want {
+			t.Errorf("SayHelloASCII(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
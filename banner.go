@@ -0,0 +1,47 @@
+package hello
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// bannerReplacer turns line endings and tabs, which have no fixed display
+// width, into characters that do.
+var bannerReplacer = strings.NewReplacer("\r\n", "\n", "\r", " ", "\t", " ")
+
+// SayHelloBanner renders SayHello(name) inside an ASCII box for splash
+// screens:
+//
+//	+--------------+
+//	| Hello World! |
+//	+--------------+
+//
+// The box is sized by display width rather than bytes or runes, so wide
+// characters such as CJK ideographs count as two columns and combining
+// marks as none, keeping the border aligned in a monospaced terminal. A
+// name containing newlines produces one padded row per line; "\r\n" is
+// treated as a newline, and a lone "\r" or a tab as a single space. Other
+// control characters are not supported. The result has no trailing
+// newline.
+func SayHelloBanner(name string) string {
+	lines := strings.Split(SayHello(bannerReplacer.Replace(name)), "\n")
+	width := 0
+	for _, line := range lines {
+		if w := uniseg.StringWidth(line); w > width {
+			width = w
+		}
+	}
+	border := "+" + strings.Repeat("-", width+2) + "+"
+	var b strings.Builder
+	b.WriteString(border)
+	for _, line := range lines {
+		b.WriteString("\n| ")
+		b.WriteString(line)
+		b.WriteString(strings.Repeat(" ", width-uniseg.StringWidth(line)))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+	b.WriteString(border)
+	return b.String()
+}
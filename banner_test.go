@@ -0,0 +1,58 @@
+package hello
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rivo/uniseg"
+)
+
+func TestSayHelloBanner(t *testing.T) {
+	want := "+--------------+\n" +
+		"| Hello World! |\n" +
+		"+--------------+"
+	if got := SayHelloBanner("World"); got != want {
+		t.Errorf("SayHelloBanner(\"World\") is\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSayHelloBannerWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		width int // display width of SayHello(name)
+	}{
+		{"World", 12},
+		{"世界", 11},
+		{"山田太郎", 15},
+		{"Rene\u0301e", 12},
+		{"👋", 9},
+	}
+	for _, tt := range tests {
+		banner := SayHelloBanner(tt.name)
+		lines := strings.Split(banner, "\n")
+		if len(lines) != 3 {
+			t.Fatalf("SayHelloBanner(%q) has %d lines; want 3:\n%s", tt.name, len(lines), banner)
+		}
+		if got, want := len(lines[0]), tt.width+4; got != want {
+			t.Errorf("SayHelloBanner(%q) border is %d wide; want %d", tt.name, got, want)
+		}
+		if lines[2] != lines[0] {
+			t.Errorf("SayHelloBanner(%q) bottom border %q differs from top %q", tt.name, lines[2], lines[0])
+		}
+		if got, want := uniseg.StringWidth(lines[1]), len(lines[0]); got != want {
+			t.Errorf("SayHelloBanner(%q) row is %d columns; want %d", tt.name, got, want)
+		}
+	}
+}
+
+func TestSayHelloBannerMultiline(t *testing.T) {
+	want := "+----------------+\n" +
+		"| Hello Ada      |\n" +
+		"| Lovelace King! |\n" +
+		"+----------------+"
+	for _, name := range []string{"Ada\nLovelace King", "Ada\r\nLovelace King", "Ada\nLovelace\tKing", "Ada\nLovelace\rKing"} {
+		if got := SayHelloBanner(name); got != want {
+			t.Errorf("SayHelloBanner(%q) is\n%s\nwant\n%s", name, got, want)
+		}
+	}
+}
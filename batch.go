@@ -0,0 +1,88 @@
+package hello
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SayHelloAll greets each name with SayHello, returning the greetings in
+// the same order. Empty names are greeted like any other so that the
+// result always lines up index for index with names.
+func SayHelloAll(names []string) []string {
+	greetings := make([]string, len(names))
+	for i, name := range names {
+		greetings[i] = SayHello(name)
+	}
+	return greetings
+}
+
+// SayHelloJoined greets all names in a single sentence, separating them
+// with commas and placing conj before the last one, e.g.
+// SayHelloJoined([]string{"Alice", "Bob", "Carol"}, "and") returns
+// "Hello Alice, Bob and Carol!". Names are trimmed of surrounding
+// whitespace, and those left empty are skipped; if none remain the
+// result is SayHello("").
+func SayHelloJoined(names []string, conj string) string {
+	kept := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			kept = append(kept, name)
+		}
+	}
+	if len(kept) < 2 {
+		return SayHello(strings.Join(kept, ""))
+	}
+	last := len(kept) - 1
+	return SayHello(strings.Join(kept[:last], ", ") + " " + conj + " " + kept[last])
+}
+
+// SayHelloAllContext is like SayHelloAll but checks ctx before greeting
+// each name, returning nil and ctx.Err() as soon as ctx is done.
+func SayHelloAllContext(ctx context.Context, names []string) ([]string, error) {
+	greetings := make([]string, len(names))
+	for i, name := range names {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		greetings[i] = SayHello(name)
+	}
+	return greetings, nil
+}
+
+// SayHelloGroup greets primary on behalf of a group, mentioning how many
+// others are present: "Hello World and 1 other!", "Hello World and 3
+// others!". When othersCount is zero or negative only primary is greeted.
+func SayHelloGroup(primary string, othersCount int) string {
+	switch {
+	case othersCount <= 0:
+		return SayHello(primary)
+	case othersCount == 1:
+		return SayHello(primary + " and 1 other")
+	default:
+		return SayHello(primary + " and " + strconv.Itoa(othersCount) + " others")
+	}
+}
+
+// NameLocale is a name paired with the locale to greet it in.
+type NameLocale struct {
+	Name   string
+	Locale string
+}
+
+// SayHelloMixed greets each entry in its own locale as SayHelloLocale
+// does, returning the greetings in order. If an entry's locale is
+// unknown, SayHelloMixed returns no greetings and an error wrapping
+// ErrUnknownLocale that names the index of the first such entry.
+func SayHelloMixed(entries []NameLocale) ([]string, error) {
+	greetings := make([]string, len(entries))
+	for i, e := range entries {
+		msg, err := SayHelloLocale(e.Locale, e.Name)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		greetings[i] = msg
+	}
+	return greetings, nil
+}
@@ -0,0 +1,142 @@
+package hello
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSayHelloAll(t *testing.T) {
+	names := []string{"Alice", "", "嗨"}
+	want := []string{"Hello Alice!", "Hello !", "Hello 嗨!"}
+	if got := SayHelloAll(names); !reflect.DeepEqual(got, want) {
+		t.Errorf("SayHelloAll(%q) is %q; want %q", names, got, want)
+	}
+	if got := SayHelloAll(nil); len(got) != 0 {
+		t.Errorf("SayHelloAll(nil) is %q; want empty", got)
+	}
+}
+
+func TestSayHelloJoined(t *testing.T) {
+	tests := []struct {
+		names []string
+		conj  string
+		want  string
+	}{
+		{nil, "and", "Hello !"},
+		{[]string{"Alice"}, "and", "Hello Alice!"},
+		{[]string{"Alice", "Bob"}, "and", "Hello Alice and Bob!"},
+		{[]string{"Alice", "Bob", "Carol"}, "and", "Hello Alice, Bob and Carol!"},
+		{[]string{"Alice", "Bob", "Carol", "Dave"}, "or", "Hello Alice, Bob, Carol or Dave!"},
+		{[]string{"", "Alice", " ", "Bob"}, "and", "Hello Alice and Bob!"},
+		{[]string{" Alice ", "Bob\t"}, "and", "Hello Alice and Bob!"},
+		{[]string{"  Alice"}, "and", "Hello Alice!"},
+		{[]string{"Анна", "Борис", "Вера"}, "и", "Hello Анна, Борис и Вера!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloJoined(tt.names, tt.conj); got != tt.want {
+			t.Errorf("SayHelloJoined(%q, %q) is %q; want %q", tt.names, tt.conj, got, tt.want)
+		}
+	}
+}
+
+// cancelAfter is a context that reports itself cancelled once Err has
+// been called n times, simulating cancellation partway through a loop.
+type cancelAfter struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfter) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestSayHelloAllContext(t *testing.T) {
+	names := []string{"Alice", "Bob", "嗨"}
+	got, err := SayHelloAllContext(context.Background(), names)
+	if err != nil {
+		t.Fatalf("SayHelloAllContext returned error: %v", err)
+	}
+	if want := SayHelloAll(names); !reflect.DeepEqual(got, want) {
+		t.Errorf("SayHelloAllContext(%q) is %q; want %q", names, got, want)
+	}
+}
+
+func TestSayHelloAllContextCancelled(t *testing.T) {
+	names := []string{"Alice", "Bob", "Carol", "Dave"}
+	ctx := &cancelAfter{Context: context.Background(), n: 2}
+	got, err := SayHelloAllContext(ctx, names)
+	if err != context.Canceled {
+		t.Errorf("SayHelloAllContext error is %v; want %v", err, context.Canceled)
+	}
+	if got != nil {
+		t.Errorf("SayHelloAllContext is %q; want nil", got)
+	}
+
+	cctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := SayHelloAllContext(cctx, names); err != context.Canceled {
+		t.Errorf("SayHelloAllContext with cancelled context error is %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestSayHelloGroup(t *testing.T) {
+	tests := []struct {
+		primary string
+		others  int
+		want    string
+	}{
+		{"World", 0, "Hello World!"},
+		{"World", 1, "Hello World and 1 other!"},
+		{"World", 2, "Hello World and 2 others!"},
+		{"World", 1000000, "Hello World and 1000000 others!"},
+		{"World", -3, "Hello World!"},
+		{"嗨", 1, "Hello 嗨 and 1 other!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloGroup(tt.primary, tt.others); got != tt.want {
+			t.Errorf("SayHelloGroup(%q, %d) is %q; want %q", tt.primary, tt.others, got, tt.want)
+		}
+	}
+}
+
+func TestSayHelloMixed(t *testing.T) {
+	entries := []NameLocale{
+		{Name: "Ada", Locale: "en"},
+		{Name: "世界", Locale: "ja"},
+		{Name: "Mundo", Locale: "es-MX"},
+	}
+	got, err := SayHelloMixed(entries)
+	if err != nil {
+		t.Fatalf("SayHelloMixed returned error: %v", err)
+	}
+	want := []string{"Hello Ada!", "世界さん、こんにちは！", "¡Hola, Mundo!"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SayHelloMixed is %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloMixedUnknownLocale(t *testing.T) {
+	entries := []NameLocale{
+		{Name: "Ada", Locale: "en"},
+		{Name: "Worf", Locale: "tlh"},
+		{Name: "Mundo", Locale: "es"},
+		{Name: "Zorg", Locale: "xx"},
+	}
+	got, err := SayHelloMixed(entries)
+	if !errors.Is(err, ErrUnknownLocale) {
+		t.Fatalf("SayHelloMixed error is %v; want ErrUnknownLocale", err)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "entry 1") || !strings.Contains(msg, `"tlh"`) {
+		t.Errorf("SayHelloMixed error is %q; want it to name entry 1 and \"tlh\"", msg)
+	}
+	if got != nil {
+		t.Errorf("SayHelloMixed is %q; want nil", got)
+	}
+}
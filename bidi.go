@@ -0,0 +1,36 @@
+package hello
+
+import "golang.org/x/text/unicode/bidi"
+
+// Unicode directional isolates, see UAX #9.
+const (
+	lri = "\u2066" // LEFT-TO-RIGHT ISOLATE
+	rli = "\u2067" // RIGHT-TO-LEFT ISOLATE
+	fsi = "\u2068" // FIRST STRONG ISOLATE
+	pdi = "\u2069" // POP DIRECTIONAL ISOLATE
+)
+
+// SayHelloBiDi greets name with the name wrapped in a directional
+// isolate, so a right-to-left name cannot reorder the surrounding
+// left-to-right text. The isolate is chosen from the name's first
+// strongly directional character: RLI for Arabic, Hebrew and other
+// right-to-left scripts, LRI for left-to-right scripts, and FSI when the
+// name has no strong character at all. The isolate is always closed with
+// PDI immediately before the trailing "!".
+func SayHelloBiDi(name string) string {
+	return SayHello(isolate(name) + name + pdi)
+}
+
+func isolate(s string) string {
+	for i := 0; i < len(s); {
+		p, size := bidi.LookupString(s[i:])
+		switch p.Class() {
+		case bidi.R, bidi.AL:
+			return rli
+		case bidi.L:
+			return lri
+		}
+		i += size
+	}
+	return fsi
+}
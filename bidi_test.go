@@ -0,0 +1,37 @@
+package hello
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSayHelloBiDiArabic(t *testing.T) {
+	name := "محمد"
+	msg := SayHelloBiDi(name)
+	if want := "Hello \u2067" + name + "\u2069!"; msg != want {
+		t.Fatalf("SayHelloBiDi(%q) is %+q; want %+q", name, msg, want)
+	}
+	if i := strings.Index(msg, "\u2067"); i != len("Hello ") {
+		t.Errorf("RLI is at byte %d; want %d", i, len("Hello "))
+	}
+	if i, want := strings.Index(msg, "\u2069"), len(msg)-len("\u2069!"); i != want {
+		t.Errorf("PDI is at byte %d; want %d", i, want)
+	}
+}
+
+func TestSayHelloBiDi(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"שלום", "Hello \u2067שלום\u2069!"},
+		{"World", "Hello \u2066World\u2069!"},
+		{"123 محمد", "Hello \u2067123 محمد\u2069!"},
+		{"123", "Hello \u2068123\u2069!"},
+		{"", "Hello \u2068\u2069!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloBiDi(tt.name); got != tt.want {
+			t.Errorf("SayHelloBiDi(%q) is %+q; want %+q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package hello
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A Catalog holds greeting patterns keyed by locale.
+type Catalog struct {
+	patterns map[string]string
+}
+
+// LoadCatalog reads a catalog of greetings from r. Each line maps a
+// BCP-47 locale to a pattern in which {name} marks where the name goes:
+//
+//	# Greetings
+//	en = Hello {name}!
+//	es = ¡Hola, {name}!
+//	ja = {name}さん、こんにちは！
+//
+// Whitespace around the locale and the pattern is ignored, as are blank
+// lines and lines starting with '#'. Lines without '=', with an empty
+// locale, without {name}, or repeating an earlier locale are reported as
+// ErrMalformedCatalog along with their line number.
+func LoadCatalog(r io.Reader) (*Catalog, error) {
+	c := &Catalog{patterns: make(map[string]string)}
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, pattern, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d: missing '='", ErrMalformedCatalog, n)
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		pattern = strings.TrimSpace(pattern)
+		switch {
+		case key == "":
+			return nil, fmt.Errorf("%w: line %d: empty locale", ErrMalformedCatalog, n)
+		case !strings.Contains(pattern, "{name}"):
+			return nil, fmt.Errorf("%w: line %d: pattern for %q has no {name}", ErrMalformedCatalog, n, key)
+		}
+		if _, dup := c.patterns[key]; dup {
+			return nil, fmt.Errorf("%w: line %d: duplicate locale %q", ErrMalformedCatalog, n, key)
+		}
+		c.patterns[key] = pattern
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Hello greets name with the catalog's pattern for locale. An exact match
+// such as "pt-br" is preferred; otherwise the primary language subtag is
+// tried. If neither is present Hello returns ErrUnknownLocale.
+func (c *Catalog) Hello(locale, name string) (string, error) {
+	pattern, ok := c.patterns[strings.ToLower(locale)]
+	if !ok {
+		pattern, ok = c.patterns[baseLanguage(locale)]
+	}
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+	}
+	return strings.ReplaceAll(pattern, "{name}", name), nil
+}
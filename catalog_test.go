@@ -0,0 +1,67 @@
+package hello
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testCatalog = `# Test greetings
+en = Hello {name}!
+
+es=¡Hola, {name}!
+pt-BR = Oi {name}!
+pt = Olá {name}!
+ja = {name}さん、こんにちは！
+`
+
+func TestCatalogHello(t *testing.T) {
+	c, err := LoadCatalog(strings.NewReader(testCatalog))
+	if err != nil {
+		t.Fatalf("LoadCatalog returned error: %v", err)
+	}
+	tests := []struct {
+		locale, name, want string
+	}{
+		{"en", "World", "Hello World!"},
+		{"en-US", "World", "Hello World!"},
+		{"es", "Mundo", "¡Hola, Mundo!"},
+		{"pt-br", "Mundo", "Oi Mundo!"},
+		{"pt-PT", "Mundo", "Olá Mundo!"},
+		{"ja", "世界", "世界さん、こんにちは！"},
+	}
+	for _, tt := range tests {
+		got, err := c.Hello(tt.locale, tt.name)
+		if err != nil {
+			t.Errorf("Hello(%q, %q) returned error: %v", tt.locale, tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Hello(%q, %q) is %q; want %q", tt.locale, tt.name, got, tt.want)
+		}
+	}
+	if _, err := c.Hello("de", "Welt"); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("Hello(\"de\", \"Welt\") error is %v; want ErrUnknownLocale", err)
+	}
+}
+
+func TestLoadCatalogMalformed(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"en = Hello {name}!\nes Hola {name}", "line 2: missing '='"},
+		{"\n\n = Hello {name}!", "line 3: empty locale"},
+		{"en = Hello!", `line 1: pattern for "en" has no {name}`},
+		{"en = Hello {name}!\n# en\nEN = Hi {name}!", `line 3: duplicate locale "en"`},
+	}
+	for _, tt := range tests {
+		_, err := LoadCatalog(strings.NewReader(tt.input))
+		if !errors.Is(err, ErrMalformedCatalog) {
+			t.Errorf("LoadCatalog(%q) error is %v; want ErrMalformedCatalog", tt.input, err)
+			continue
+		}
+		if !strings.HasSuffix(err.Error(), tt.want) {
+			t.Errorf("LoadCatalog(%q) error is %q; want suffix %q", tt.input, err, tt.want)
+		}
+	}
+}
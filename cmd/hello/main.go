@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"hello"
+	"io"
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run greets each name in args on its own line of stdout and returns the
+// process exit status, reporting usage and errors on stderr. With no
+// names it greets "World".
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("hello", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	greeting := fs.String("greeting", "", "greeting word to use instead of the locale's")
+	locale := fs.String("locale", "en", "BCP-47 language tag of the greeting")
+	asJSON := fs.Bool("json", false, "print each greeting as a JSON object")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if _, err := hello.SayHelloLocale(*locale, ""); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	g := hello.NewGreeter(hello.WithLocale(*locale), hello.WithGreeting(*greeting))
+	names := fs.Args()
+	if len(names) == 0 {
+		names = []string{"World"}
+	}
+	enc := json.NewEncoder(stdout)
+	enc.SetEscapeHTML(false)
+	for _, name := range names {
+		msg := g.Hello(name)
+		var err error
+		if *asJSON {
+			err = enc.Encode(hello.Greeting{Name: name, Message: msg, Locale: *locale})
+		} else {
+			_, err = fmt.Fprintln(stdout, msg)
+		}
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+	return 0
+}
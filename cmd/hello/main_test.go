@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{nil, "Hello World!\n"},
+		{[]string{"Alice", "嗨"}, "Hello Alice!\nHello 嗨!\n"},
+		{[]string{"-greeting", "Hi", "Alice"}, "Hi Alice!\n"},
+		{[]string{"-locale", "es", "Mundo"}, "¡Hola, Mundo!\n"},
+		{[]string{"-locale", "ja", "-greeting", "やあ", "世界"}, "世界さん、やあ！\n"},
+		{[]string{"-json", "World"}, `{"name":"World","message":"Hello World!","locale":"en"}` + "\n"},
+		{[]string{"-json", "-locale", "de", "Welt"}, `{"name":"Welt","message":"Hallo Welt!","locale":"de"}` + "\n"},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		if code := run(tt.args, &stdout, &stderr); code != 0 {
+			t.Errorf("run(%q) exit status is %d; want 0", tt.args, code)
+		}
+		if got := stdout.String(); got != tt.want {
+			t.Errorf("run(%q) printed %q; want %q", tt.args, got, tt.want)
+		}
+		if stderr.Len() != 0 {
+			t.Errorf("run(%q) wrote %q to stderr; want nothing", tt.args, stderr.String())
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		code int
+		msg  string
+	}{
+		{[]string{"-locale", "xx", "World"}, 1, `hello: unknown locale: "xx"` + "\n"},
+		{[]string{"-nope"}, 2, "flag provided but not defined: -nope"},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		if code := run(tt.args, &stdout, &stderr); code != tt.code {
+			t.Errorf("run(%q) exit status is %d; want %d", tt.args, code, tt.code)
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("run(%q) printed %q; want nothing", tt.args, stdout.String())
+		}
+		if got := stderr.String(); !strings.HasPrefix(got, tt.msg) {
+			t.Errorf("run(%q) wrote %q to stderr; want prefix %q", tt.args, got, tt.msg)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package hello
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+)
+
+// HelloEqual reports whether greetings a and b are the same apart from
+// case and spacing. Both are compared after two normalizations and
+// nothing else:
+//
+//   - leading and trailing whitespace is removed and every interior run
+//     of whitespace becomes a single space, using unicode.IsSpace;
+//   - text is case folded with full Unicode folding, so "ß" matches
+//     "SS" and "Σ" matches "ς".
+//
+// Punctuation, accents and Unicode normalization form are significant:
+// "Hello World!" does not equal "Hello World" and a precomposed "é" does
+// not equal "e" followed by U+0301.
+func HelloEqual(a, b string) bool {
+	return foldGreeting(a) == foldGreeting(b)
+}
+
+func foldGreeting(s string) string {
+	return cases.Fold().String(strings.Join(strings.Fields(s), " "))
+}
@@ -0,0 +1,32 @@
+package hello
+
+import "testing"
+
+func TestHelloEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"Hello World!", "Hello World!", true},
+		{"Hello World!", "hello world!", true},
+		{"Hello World!", "HELLO WORLD!", true},
+		{"Hello Straße!", "HELLO STRASSE!", true},
+		{"Hello ΣΟΦΊΑ!", "hello σοφία!", true},
+		{"Hello World!", "  Hello   World! ", true},
+		{"Hello World!", "Hello\tWorld!", true},
+		{"Hello World!", "Hello\u00a0World!", true},
+		{"Hello World!", "Hello World", false},
+		{"Hello World!", "Hello Word!", false},
+		{"Hello World!", "HelloWorld!", false},
+		{"Hello José!", "Hello Jose!", false},
+		{"Hello José!", "Hello Jose\u0301!", false},
+	}
+	for _, tt := range tests {
+		if got := HelloEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("HelloEqual(%q, %q) is %v; want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := HelloEqual(tt.b, tt.a); got != tt.want {
+			t.Errorf("HelloEqual(%q, %q) is %v; want %v", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package hello
+
+import "sync/atomic"
+
+// greetingCount is updated atomically; as a package-level variable it is
+// 64-bit aligned on every platform.
+var greetingCount uint64
+
+// SayHelloCounted is SayHello that also increments the count reported by
+// GreetingCount. Other functions in this package do not count.
+func SayHelloCounted(name string) string {
+	atomic.AddUint64(&greetingCount, 1)
+	return SayHello(name)
+}
+
+// GreetingCount returns how many greetings SayHelloCounted has produced
+// since the program started or ResetGreetingCount was last called.
+func GreetingCount() uint64 {
+	return atomic.LoadUint64(&greetingCount)
+}
+
+// ResetGreetingCount sets the count reported by GreetingCount to zero.
+func ResetGreetingCount() {
+	atomic.StoreUint64(&greetingCount, 0)
+}
@@ -0,0 +1,40 @@
+package hello
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSayHelloCounted(t *testing.T) {
+	ResetGreetingCount()
+	if got, want := SayHelloCounted("World"), SayHello("World"); got != want {
+		t.Errorf("SayHelloCounted(\"World\") is %q; want %q", got, want)
+	}
+	SayHello("World")
+	if n := GreetingCount(); n != 1 {
+		t.Errorf("GreetingCount() is %d; want 1", n)
+	}
+	ResetGreetingCount()
+	if n := GreetingCount(); n != 0 {
+		t.Errorf("GreetingCount() after reset is %d; want 0", n)
+	}
+}
+
+func TestSayHelloCountedConcurrent(t *testing.T) {
+	const goroutines, calls = 50, 200
+	ResetGreetingCount()
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < calls; j++ {
+				SayHelloCounted("嗨")
+			}
+		}()
+	}
+	wg.Wait()
+	if n := GreetingCount(); n != goroutines*calls {
+		t.Errorf("GreetingCount() is %d; want %d", n, goroutines*calls)
+	}
+}
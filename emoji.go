@@ -0,0 +1,17 @@
+package hello
+
+// WavingHand is the emoji SayHelloEmoji decorates greetings with.
+const WavingHand = "\U0001F44B"
+
+// SayHelloEmoji greets name and appends a waving hand, e.g. "Hello
+// World! 👋".
+func SayHelloEmoji(name string) string {
+	return SayHelloEmojiWith(name, WavingHand)
+}
+
+// SayHelloEmojiWith is like SayHelloEmoji but appends emoji instead of
+// WavingHand. emoji may be any string, including a multi-rune sequence
+// such as a skin-tone modified or ZWJ emoji.
+func SayHelloEmojiWith(name, emoji string) string {
+	return SayHello(name) + " " + emoji
+}
@@ -0,0 +1,50 @@
+package hello
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+func TestSayHelloEmoji(t *testing.T) {
+	msg := SayHelloEmoji("World")
+	if want := "Hello World! 👋"; msg != want {
+		t.Errorf("SayHelloEmoji(\"World\") is %q; want %q", msg, want)
+	}
+	if n := strings.Count(msg, WavingHand); n != 1 {
+		t.Errorf("SayHelloEmoji(\"World\") contains %d waving hands; want 1", n)
+	}
+	if !strings.HasPrefix(msg, SayHello("World")) {
+		t.Errorf("SayHelloEmoji(\"World\") is %q; want prefix %q", msg, SayHello("World"))
+	}
+}
+
+func TestSayHelloEmojiLengths(t *testing.T) {
+	tests := []struct {
+		emoji string
+		runes int
+	}{
+		{WavingHand, 1},
+		{"👋\U0001F3FD", 2},    // waving hand, medium skin tone
+		{"🧑\u200d💻", 3},       // technologist ZWJ sequence
+		{"🏳\ufe0f\u200d🌈", 4}, // rainbow flag
+	}
+	for _, tt := range tests {
+		msg := SayHelloEmojiWith("World", tt.emoji)
+		base := SayHello("World")
+		if n := strings.Count(msg, tt.emoji); n != 1 {
+			t.Errorf("SayHelloEmojiWith(\"World\", %+q) contains the emoji %d times; want 1", tt.emoji, n)
+		}
+		if got, want := len(msg), len(base)+1+len(tt.emoji); got != want {
+			t.Errorf("len(SayHelloEmojiWith(\"World\", %+q)) is %d; want %d", tt.emoji, got, want)
+		}
+		if got, want := utf8.RuneCountInString(msg), len(base)+1+tt.runes; got != want {
+			t.Errorf("rune count of SayHelloEmojiWith(\"World\", %+q) is %d; want %d", tt.emoji, got, want)
+		}
+		if got, want := uniseg.GraphemeClusterCount(msg), HelloLength("World")+2; got != want {
+			t.Errorf("grapheme count of SayHelloEmojiWith(\"World\", %+q) is %d; want %d", tt.emoji, got, want)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package hello
+
+import "errors"
+
+// Errors returned by this package. Functions may wrap them with more
+// detail, so compare with errors.Is rather than ==.
+var (
+	// ErrEmptyName is returned when a name is empty or only whitespace.
+	ErrEmptyName = errors.New("hello: empty name")
+
+	// ErrUnknownLocale is returned when a locale has no greeting in the
+	// built-in table or in a Catalog.
+	ErrUnknownLocale = errors.New("hello: unknown locale")
+
+	// ErrBadTemplate is returned by SayHelloTemplate when a template
+	// cannot be parsed or executed.
+	ErrBadTemplate = errors.New("hello: bad template")
+
+	// ErrMalformedGreeting is returned by ParseHello for input that
+	// SayHello could not have produced.
+	ErrMalformedGreeting = errors.New("hello: malformed greeting")
+
+	// ErrUnknownVariant is returned by SayHelloVariant for a variant that
+	// has not been registered.
+	ErrUnknownVariant = errors.New("hello: unknown variant")
+
+	// ErrMalformedCatalog is returned by LoadCatalog for input that does
+	// not follow the catalog format.
+	ErrMalformedCatalog = errors.New("hello: malformed catalog")
+)
@@ -0,0 +1,63 @@
+package hello
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestErrorsIs(t *testing.T) {
+	_, errEmpty := SayHelloChecked(" ")
+	_, errLocale := SayHelloLocale("xx", "World")
+	_, errGoodbye := SayGoodbyeLocale("xx", "World")
+	_, errMixed := SayHelloMixed([]NameLocale{{Name: "World", Locale: "xx"}})
+	_, errParse := SayHelloTemplate("{{.Greeting", "World")
+	_, errExec := SayHelloTemplate("{{.Surname}}", "World")
+	_, errGreeting := ParseHello("Hi World!")
+	_, errVariant := SayHelloVariant("test-missing", "World")
+	_, errCatalog := LoadCatalog(strings.NewReader("en Hello {name}!"))
+	c, _ := LoadCatalog(strings.NewReader("en = Hello {name}!"))
+	_, errCatalogLocale := c.Hello("xx", "World")
+
+	tests := []struct {
+		desc   string
+		err    error
+		target error
+	}{
+		{"SayHelloChecked", errEmpty, ErrEmptyName},
+		{"SayHelloLocale", errLocale, ErrUnknownLocale},
+		{"SayGoodbyeLocale", errGoodbye, ErrUnknownLocale},
+		{"SayHelloMixed", errMixed, ErrUnknownLocale},
+		{"SayHelloTemplate parse", errParse, ErrBadTemplate},
+		{"SayHelloTemplate execute", errExec, ErrBadTemplate},
+		{"ParseHello", errGreeting, ErrMalformedGreeting},
+		{"SayHelloVariant", errVariant, ErrUnknownVariant},
+		{"LoadCatalog", errCatalog, ErrMalformedCatalog},
+		{"Catalog.Hello", errCatalogLocale, ErrUnknownLocale},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, tt.target) {
+			t.Errorf("%s error %v does not match %v", tt.desc, tt.err, tt.target)
+		}
+		wrapped := fmt.Errorf("greeting: %w", tt.err)
+		if !errors.Is(wrapped, tt.target) {
+			t.Errorf("%s error wrapped with %%w does not match %v", tt.desc, tt.target)
+		}
+	}
+}
+
+func TestErrBadTemplateUnwrap(t *testing.T) {
+	_, err := SayHelloTemplate("{{.Surname}}", "World")
+	var execErr template.ExecError
+	if !errors.As(err, &execErr) {
+		t.Errorf("SayHelloTemplate error %v does not wrap a template.ExecError", err)
+	}
+	if !strings.HasPrefix(err.Error(), "hello: bad template: ") {
+		t.Errorf("SayHelloTemplate error is %q; want prefix %q", err, "hello: bad template: ")
+	}
+	if errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("SayHelloTemplate error %v matches ErrUnknownLocale", err)
+	}
+}
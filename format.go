@@ -0,0 +1,36 @@
+package hello
+
+import "strings"
+
+// SayHellof formats a greeting for name from format, replacing these
+// verbs:
+//
+//	%n  the name as given
+//	%N  the name title-cased by TitleCase
+//	%%  a literal percent sign
+//
+// Any other verb, and a '%' at the very end of format, is copied through
+// unchanged. For example SayHellof("Welcome back, %N!", "world") returns
+// "Welcome back, World!".
+func SayHellof(format, name string) string {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(format, '%')
+		if i < 0 || i == len(format)-1 {
+			b.WriteString(format)
+			return b.String()
+		}
+		b.WriteString(format[:i])
+		switch format[i+1] {
+		case 'n':
+			b.WriteString(name)
+		case 'N':
+			b.WriteString(TitleCase(name))
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteString(format[i : i+2])
+		}
+		format = format[i+2:]
+	}
+}
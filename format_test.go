@@ -0,0 +1,26 @@
+package hello
+
+import "testing"
+
+func TestSayHellof(t *testing.T) {
+	tests := []struct {
+		format, name, want string
+	}{
+		{"Welcome back, %N!", "world", "Welcome back, World!"},
+		{"Hello %n!", "wORLD", "Hello wORLD!"},
+		{"%N, you are %n", "ada lovelace", "Ada Lovelace, you are ada lovelace"},
+		{"100%% %n", "World", "100% World"},
+		{"%d %s %n", "World", "%d %s World"},
+		{"trailing %", "World", "trailing %"},
+		{"%%n", "World", "%n"},
+		{"no verbs", "World", "no verbs"},
+		{"", "World", ""},
+		{"こんにちは、%Nさん", "émile", "こんにちは、Émileさん"},
+		{"%n！", "嗨", "嗨！"},
+	}
+	for _, tt := range tests {
+		if got := SayHellof(tt.format, tt.name); got != tt.want {
+			t.Errorf("SayHellof(%q, %q) is %q; want %q", tt.format, tt.name, got, tt.want)
+		}
+	}
+}
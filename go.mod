@@ -1,3 +1,8 @@
 module hello
 
 go 1.18
+
+require (
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/text v0.14.0
+)
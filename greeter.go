@@ -0,0 +1,139 @@
+package hello
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// A Greeter builds greetings from a fixed configuration. The zero value
+// greets exactly like SayHello. A Greeter is not modified after
+// construction, so it is safe for concurrent use.
+type Greeter struct {
+	greeting string
+	sep      string
+	hasSep   bool
+	locale   string
+	title    bool
+	titleTag language.Tag
+	acronyms bool
+}
+
+// An Option configures a Greeter.
+type Option func(*Greeter)
+
+// WithGreeting replaces the locale's greeting word, e.g. "Hi".
+func WithGreeting(word string) Option {
+	return func(g *Greeter) { g.greeting = word }
+}
+
+// WithSeparator replaces the text placed between the greeting word and
+// the name. An empty separator is honoured.
+func WithSeparator(sep string) Option {
+	return func(g *Greeter) {
+		g.sep = sep
+		g.hasSep = true
+	}
+}
+
+// WithLocale selects the greeting word, separator and word order of a
+// language from the table used by SayHelloLocale. Unknown locales fall
+// back to English.
+func WithLocale(tag string) Option {
+	return func(g *Greeter) { g.locale = tag }
+}
+
+// WithTitleCase title-cases names before greeting them, so "wORLD"
+// becomes "World". Casing follows the rules of the BCP-47 language lang:
+// with "tr" or "az", "istanbul" becomes "İstanbul" rather than
+// "Istanbul", while with "und" language-neutral rules apply. An invalid
+// lang is treated as "und".
+func WithTitleCase(lang string) Option {
+	return func(g *Greeter) {
+		g.title = true
+		g.titleTag = language.Make(lang)
+	}
+}
+
+// WithPreserveAcronyms makes WithTitleCase leave acronyms alone: words of
+// two or more letters with no lowercase letters, such as "NASA" or
+// "IBM", keep their casing while other words are still title-cased, so
+// "john NASA" becomes "John NASA". Acronyms can only be recognized by
+// their capitals, so a lowercase "nasa" is still title-cased to "Nasa".
+// Without WithTitleCase this option has no effect.
+func WithPreserveAcronyms() Option {
+	return func(g *Greeter) { g.acronyms = true }
+}
+
+// NewGreeter returns a Greeter configured by opts, applied in order.
+func NewGreeter(opts ...Option) *Greeter {
+	g := new(Greeter)
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Hello greets name using g's configuration.
+func (g *Greeter) Hello(name string) string {
+	l, ok := lookupLocale(g.locale)
+	if !ok {
+		l = locales["en"]
+	}
+	word := l.hello
+	if g.greeting != "" {
+		word = g.greeting
+	}
+	if g.hasSep {
+		l.sep = g.sep
+	}
+	if g.title {
+		name = g.titleCase(name)
+	}
+	return l.greet(word, name)
+}
+
+func (g *Greeter) titleCase(name string) string {
+	c := cases.Title(g.titleTag)
+	if !g.acronyms {
+		return c.String(name)
+	}
+	var b strings.Builder
+	for name != "" {
+		i := strings.IndexFunc(name, func(r rune) bool { return !unicode.IsSpace(r) })
+		if i < 0 {
+			i = len(name)
+		}
+		b.WriteString(name[:i])
+		name = name[i:]
+		j := strings.IndexFunc(name, unicode.IsSpace)
+		if j < 0 {
+			j = len(name)
+		}
+		word := name[:j]
+		name = name[j:]
+		if !isAcronym(word) {
+			word = c.String(word)
+		}
+		b.WriteString(word)
+	}
+	return b.String()
+}
+
+// isAcronym reports whether word has at least two letters and none of
+// them is lowercase.
+func isAcronym(word string) bool {
+	letters := 0
+	for _, r := range word {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if unicode.IsLower(r) {
+			return false
+		}
+		letters++
+	}
+	return letters >= 2
+}
@@ -0,0 +1,107 @@
+package hello
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestGreeterZeroValue(t *testing.T) {
+	var g Greeter
+	for _, name := range []string{"World", "嗨", ""} {
+		if got, want := g.Hello(name), SayHello(name); got != want {
+			t.Errorf("Greeter{}.Hello(%q) is %q; want %q", name, got, want)
+		}
+	}
+	if got, want := NewGreeter().Hello("World"), SayHello("World"); got != want {
+		t.Errorf("NewGreeter().Hello(\"World\") is %q; want %q", got, want)
+	}
+}
+
+func TestGreeterOptions(t *testing.T) {
+	tests := []struct {
+		opts []Option
+		want string
+	}{
+		{[]Option{WithGreeting("Hi")}, "Hi World!"},
+		{[]Option{WithSeparator(", ")}, "Hello, World!"},
+		{[]Option{WithLocale("es")}, "¡Hola, World!"},
+		{[]Option{WithLocale("ja")}, "Worldさん、こんにちは！"},
+		{[]Option{WithLocale("xx")}, "Hello World!"},
+		{[]Option{WithLocale("es"), WithGreeting("¡Buenas")}, "¡Buenas, World!"},
+		{[]Option{WithLocale("es"), WithSeparator(" ")}, "¡Hola World!"},
+		{[]Option{WithGreeting("Hi"), WithSeparator(", "), WithLocale("de")}, "Hi, World!"},
+		{[]Option{WithGreeting("Hi"), WithGreeting("Hey")}, "Hey World!"},
+	}
+	for _, tt := range tests {
+		if got := NewGreeter(tt.opts...).Hello("World"); got != tt.want {
+			t.Errorf("NewGreeter(...).Hello(\"World\") is %q; want %q", got, tt.want)
+		}
+	}
+}
+
+func TestGreeterConcurrent(t *testing.T) {
+	g := NewGreeter(WithGreeting("Hi"), WithLocale("es"))
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := g.Hello("Mundo"); got != "Hi, Mundo!" {
+				t.Errorf("Hello(\"Mundo\") is %q; want %q", got, "Hi, Mundo!")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestGreeterTitleCase(t *testing.T) {
+	tests := []struct {
+		lang, name, want string
+	}{
+		{"en", "wORLD", "Hello World!"},
+		{"en", "ada lovelace", "Hello Ada Lovelace!"},
+		{"en", "émile", "Hello Émile!"},
+		{"de", "straße", "Hello Straße!"},
+		{"de", "ß", "Hello Ss!"},
+		{"en", "istanbul", "Hello Istanbul!"},
+		{"tr", "istanbul", "Hello İstanbul!"},
+		{"tr", "IĞDIR", "Hello Iğdır!"},
+		{"not a tag", "wORLD", "Hello World!"},
+	}
+	for _, tt := range tests {
+		if got := NewGreeter(WithTitleCase(tt.lang)).Hello(tt.name); got != tt.want {
+			t.Errorf("NewGreeter(WithTitleCase(%q)).Hello(%q) is %q; want %q", tt.lang, tt.name, got, tt.want)
+		}
+	}
+	if got := NewGreeter().Hello("wORLD"); got != "Hello wORLD!" {
+		t.Errorf("NewGreeter().Hello(\"wORLD\") is %q; want %q", got, "Hello wORLD!")
+	}
+}
+
+func TestGreeterPreserveAcronyms(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"NASA", "Hello NASA!"},
+		{"nasa", "Hello Nasa!"},
+		{"John NASA", "Hello John NASA!"},
+		{"jOHN  nasa IBM", "Hello John  Nasa IBM!"},
+		{"A", "Hello A!"},
+		{"IBM, inc.", "Hello IBM, Inc.!"},
+		{"ÉDF énergie", "Hello ÉDF Énergie!"},
+		{" CERN\tlab ", "Hello  CERN\tLab !"},
+	}
+	g := NewGreeter(WithTitleCase("en"), WithPreserveAcronyms())
+	for _, tt := range tests {
+		if got := g.Hello(tt.name); got != tt.want {
+			t.Errorf("Hello(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if got, want := NewGreeter(WithTitleCase("en")).Hello("John NASA"), "Hello John Nasa!"; got != want {
+		t.Errorf("without WithPreserveAcronyms Hello(\"John NASA\") is %q; want %q", got, want)
+	}
+	if got, want := NewGreeter(WithPreserveAcronyms()).Hello("john NASA"), "Hello john NASA!"; got != want {
+		t.Errorf("without WithTitleCase Hello(\"john NASA\") is %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package hello
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// HelloHandler is an http.Handler that greets the name given in the
+// "name" query parameter, or "World" when it is absent or empty. Requests
+// whose Accept header lists application/json with a non-zero quality
+// receive the Greeting as JSON; all others receive the plain-text
+// greeting.
+type HelloHandler struct{}
+
+func (HelloHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		name = "World"
+	}
+	if !acceptsJSON(r.Header.Get("Accept")) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		WriteHello(w, name)
+		return
+	}
+	body, err := SayHelloJSON(name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// acceptsJSON reports whether the Accept header value accept names
+// application/json without q=0. Malformed entries are ignored.
+func acceptsJSON(accept string) bool {
+	for _, entry := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(entry))
+		if err != nil || mediaType != "application/json" {
+			continue
+		}
+		q, err := strconv.ParseFloat(params["q"], 64)
+		if params["q"] == "" || err == nil && q > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package hello
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func serve(t *testing.T, target, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	HelloHandler{}.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s status is %d; want %d", target, rec.Code, http.StatusOK)
+	}
+	return rec
+}
+
+func TestHelloHandler(t *testing.T) {
+	rec := serve(t, "/?name=%E5%97%A8", "")
+	if got, want := rec.Body.String(), SayHello("嗨"); got != want {
+		t.Errorf("body is %q; want %q", got, want)
+	}
+	if got, want := rec.Header().Get("Content-Type"), "text/plain; charset=utf-8"; got != want {
+		t.Errorf("Content-Type is %q; want %q", got, want)
+	}
+}
+
+func TestHelloHandlerDefaultName(t *testing.T) {
+	for _, target := range []string{"/", "/?name="} {
+		rec := serve(t, target, "")
+		if got, want := rec.Body.String(), "Hello World!"; got != want {
+			t.Errorf("GET %s body is %q; want %q", target, got, want)
+		}
+	}
+}
+
+func TestHelloHandlerJSON(t *testing.T) {
+	rec := serve(t, "/?name=Ada", "text/html, application/json;q=0.9")
+	if got, want := rec.Body.String(), `{"name":"Ada","message":"Hello Ada!","locale":"en"}`; got != want {
+		t.Errorf("body is %s; want %s", got, want)
+	}
+	if got, want := rec.Header().Get("Content-Type"), "application/json"; got != want {
+		t.Errorf("Content-Type is %q; want %q", got, want)
+	}
+}
+
+func TestHelloHandlerJSONNotAcceptable(t *testing.T) {
+	for _, accept := range []string{
+		"application/json;q=0, text/plain",
+		"application/json; q=0.0",
+		"application/json-seq",
+		"text/plain, */*",
+	} {
+		rec := serve(t, "/?name=Ada", accept)
+		if got, want := rec.Body.String(), "Hello Ada!"; got != want {
+			t.Errorf("Accept %q: body is %q; want %q", accept, got, want)
+		}
+		if got, want := rec.Header().Get("Content-Type"), "text/plain; charset=utf-8"; got != want {
+			t.Errorf("Accept %q: Content-Type is %q; want %q", accept, got, want)
+		}
+	}
+}
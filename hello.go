@@ -1,5 +1,76 @@
 package hello
 
+import "strings"
+
+// The parts of an English greeting. SayHello(name) is always
+// Prefix + Separator + name + Suffix.
+const (
+	Prefix    = "Hello"
+	Separator = " "
+	Suffix    = "!"
+)
+
+// SayHello greets a in English, e.g. "Hello World!".
 func SayHello(a string) string {
-	return "Hello " + a + "!"
+	return SayHelloWith(Prefix, a)
+}
+
+// SayHelloWith greets name using the given greeting word in place of
+// Prefix, e.g. SayHelloWith("Hi", "World") returns "Hi World!".
+func SayHelloWith(greeting, name string) string {
+	// Sizing the builder up front keeps this to a single allocation.
+	var b strings.Builder
+	b.Grow(len(greeting) + len(Separator) + len(name) + len(Suffix))
+	b.WriteString(greeting)
+	b.WriteString(Separator)
+	b.WriteString(name)
+	b.WriteString(Suffix)
+	return b.String()
+}
+
+// SayHelloSep greets name with sep in place of Separator, e.g.
+// SayHelloSep(", ", "World") returns "Hello, World!". An empty sep joins
+// the two directly.
+func SayHelloSep(sep, name string) string {
+	return Prefix + sep + name + Suffix
+}
+
+// SayHelloSuffix greets name with suffix in place of Suffix, so the
+// closing punctuation can be changed or left out: SayHelloSuffix("World",
+// "") returns "Hello World" and SayHelloSuffix("World", " 🎉") returns
+// "Hello World 🎉". No space is added before suffix. SayHelloSuffix(name,
+// Suffix) is SayHello(name).
+func SayHelloSuffix(name, suffix string) string {
+	return Prefix + Separator + name + suffix
+}
+
+// SayGoodbye bids a farewell in English, e.g. "Goodbye World!".
+func SayGoodbye(a string) string {
+	return SayHelloWith("Goodbye", a)
+}
+
+// SayHelloChecked is like SayHello but returns ErrEmptyName instead of a
+// greeting with a dangling separator when name is empty or consists only
+// of Unicode whitespace.
+func SayHelloChecked(name string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", ErrEmptyName
+	}
+	return SayHello(name), nil
+}
+
+// SayHelloClean is like SayHello but drops Separator when name is empty
+// or only whitespace, giving a generic "Hello!" instead of "Hello !".
+func SayHelloClean(name string) string {
+	if strings.TrimSpace(name) == "" {
+		return Prefix + Suffix
+	}
+	return SayHello(name)
+}
+
+// SayHelloValid is like SayHello but always returns valid UTF-8: each
+// run of bytes in name that is not valid UTF-8 is replaced by a single
+// U+FFFD REPLACEMENT CHARACTER. Valid names are greeted unchanged.
+func SayHelloValid(name string) string {
+	return SayHello(strings.ToValidUTF8(name, "\uFFFD"))
 }
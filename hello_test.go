@@ -3,6 +3,7 @@ package hello
 import (
 	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestLength(t *testing.T) {
@@ -19,3 +20,207 @@ func TestContainsUTF(t *testing.T) {
 		t.Error("SayHello(\"嗨\") doesn't support UTF8")
 	}
 }
+
+func TestSayHelloWith(t *testing.T) {
+	msg := SayHelloWith("Hi", "World")
+	if msg != "Hi World!" {
+		t.Errorf("SayHelloWith(\"Hi\", \"World\") is %q; want %q", msg, "Hi World!")
+	}
+}
+
+func TestSayHelloWithDefault(t *testing.T) {
+	for _, name := range []string{"World", "嗨", ""} {
+		if got, want := SayHelloWith("Hello", name), SayHello(name); got != want {
+			t.Errorf("SayHelloWith(\"Hello\", %q) is %q; want %q", name, got, want)
+		}
+	}
+}
+
+func TestSayHelloWithUTF(t *testing.T) {
+	msg := SayHelloWith("こんにちは", "World")
+	if msg != "こんにちは World!" {
+		t.Errorf("SayHelloWith(\"こんにちは\", \"World\") is %q; want %q", msg, "こんにちは World!")
+	}
+	if !utf8.ValidString(msg) {
+		t.Errorf("SayHelloWith(\"こんにちは\", \"World\") is not valid UTF-8")
+	}
+}
+
+func TestSayHelloChecked(t *testing.T) {
+	msg, err := SayHelloChecked("World")
+	if err != nil || msg != "Hello World!" {
+		t.Errorf("SayHelloChecked(\"World\") is %q, %v; want %q, nil", msg, err, "Hello World!")
+	}
+}
+
+func TestSayHelloCheckedEmpty(t *testing.T) {
+	for _, name := range []string{"", " ", "\t\n", "\u3000\u3000"} {
+		if _, err := SayHelloChecked(name); err != ErrEmptyName {
+			t.Errorf("SayHelloChecked(%q) error is %v; want ErrEmptyName", name, err)
+		}
+	}
+}
+
+func TestSayHelloEmptyStillForgiving(t *testing.T) {
+	if msg := SayHello(""); msg != "Hello !" {
+		t.Errorf("SayHello(\"\") is %q; want %q", msg, "Hello !")
+	}
+}
+
+func TestGoodbyeLength(t *testing.T) {
+	msg := SayGoodbye("World")
+	if msg != "Goodbye World!" {
+		t.Errorf("SayGoodbye(\"World\") is %q; want %q", msg, "Goodbye World!")
+	}
+}
+
+func TestGoodbyeContainsUTF(t *testing.T) {
+	msg := SayGoodbye("嗨")
+	if !strings.Contains(msg, "嗨") {
+		t.Error("SayGoodbye(\"嗨\") doesn't support UTF8")
+	}
+}
+
+func TestSayHelloSep(t *testing.T) {
+	tests := []struct {
+		sep, want string
+	}{
+		{" ", "Hello World!"},
+		{"", "HelloWorld!"},
+		{", ", "Hello, World!"},
+		{": ", "Hello: World!"},
+		{"\t", "Hello\tWorld!"},
+		{" -- ", "Hello -- World!"},
+		{"、", "Hello、World!"},
+		{" → ", "Hello → World!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloSep(tt.sep, "World"); got != tt.want {
+			t.Errorf("SayHelloSep(%q, \"World\") is %q; want %q", tt.sep, got, tt.want)
+		}
+		if got := NewGreeter(WithSeparator(tt.sep)).Hello("World"); got != tt.want {
+			t.Errorf("NewGreeter(WithSeparator(%q)).Hello(\"World\") is %q; want %q", tt.sep, got, tt.want)
+		}
+	}
+}
+
+func TestSayHelloAllocs(t *testing.T) {
+	for _, name := range []string{"World", "嗨", "Ada Lovelace, Countess of Lovelace"} {
+		if got, want := SayHello(name), "Hello "+name+"!"; got != want {
+			t.Errorf("SayHello(%q) is %q; want %q", name, got, want)
+		}
+		if n := testing.AllocsPerRun(100, func() { SayHello(name) }); n != 1 {
+			t.Errorf("SayHello(%q) allocates %v times; want 1", name, n)
+		}
+	}
+}
+
+// BenchmarkSayHello reports 1 allocs/op: the builder is sized for the
+// whole greeting before anything is written.
+func BenchmarkSayHello(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SayHello("World")
+	}
+}
+
+// BenchmarkSayHelloLong is BenchmarkSayHello with a multi-byte name long
+// enough that an unsized builder would need to grow several times. It
+// also reports 1 allocs/op.
+func BenchmarkSayHelloLong(b *testing.B) {
+	name := strings.Repeat("嗨", 64)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(SayHello(name))))
+	for i := 0; i < b.N; i++ {
+		SayHello(name)
+	}
+}
+
+func TestSayHelloConstants(t *testing.T) {
+	for _, name := range []string{"World", "嗨", "José", "👨\u200d👩\u200d👧", ""} {
+		if got, want := SayHello(name), Prefix+Separator+name+Suffix; got != want {
+			t.Errorf("SayHello(%q) is %q; want %q", name, got, want)
+		}
+	}
+}
+
+func TestSayHelloClean(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"", "Hello!"},
+		{" ", "Hello!"},
+		{"\t\u3000\n", "Hello!"},
+		{"World", "Hello World!"},
+		{"嗨", "Hello 嗨!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloClean(tt.name); got != tt.want {
+			t.Errorf("SayHelloClean(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSayHelloValid(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"World", "Hello World!"},
+		{"嗨", "Hello 嗨!"},
+		{"Wor\xffld", "Hello Wor\uFFFDld!"},
+		{"\xff\xfe", "Hello \uFFFD!"},
+		{"a\xc3", "Hello a\uFFFD!"},
+		{"\xed\xa0\x80", "Hello \uFFFD!"}, // UTF-16 surrogate half
+	}
+	for _, tt := range tests {
+		got := SayHelloValid(tt.name)
+		if got != tt.want {
+			t.Errorf("SayHelloValid(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("SayHelloValid(%q) is not valid UTF-8", tt.name)
+		}
+	}
+}
+
+func FuzzSayHello(f *testing.F) {
+	for _, seed := range []string{"World", "嗨", "", "\xff", "Wor\xffld", "a\xc3", "\xed\xa0\x80", "\xf4\x90\x80\x80"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		msg := SayHello(name)
+		if got, err := ParseHello(msg); err != nil || got != name {
+			t.Errorf("ParseHello(SayHello(%q)) is %q, %v; want %q, nil", name, got, err, name)
+		}
+		valid := SayHelloValid(name)
+		if !utf8.ValidString(valid) {
+			t.Errorf("SayHelloValid(%q) is not valid UTF-8: %q", name, valid)
+		}
+		if utf8.ValidString(name) && valid != msg {
+			t.Errorf("SayHelloValid(%q) is %q; want %q", name, valid, msg)
+		}
+	})
+}
+
+func TestSayHelloSuffix(t *testing.T) {
+	tests := []struct {
+		suffix, want string
+	}{
+		{"", "Hello World"},
+		{"!", "Hello World!"},
+		{"?!", "Hello World?!"},
+		{" 🎉", "Hello World 🎉"},
+		{"🎉", "Hello World🎉"},
+		{"。", "Hello World。"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloSuffix("World", tt.suffix); got != tt.want {
+			t.Errorf("SayHelloSuffix(\"World\", %q) is %q; want %q", tt.suffix, got, tt.want)
+		}
+	}
+	for _, name := range []string{"World", "嗨", ""} {
+		if got, want := SayHelloSuffix(name, Suffix), SayHello(name); got != want {
+			t.Errorf("SayHelloSuffix(%q, Suffix) is %q; want %q", name, got, want)
+		}
+	}
+}
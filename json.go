@@ -0,0 +1,30 @@
+package hello
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Greeting is the structured form of a greeting.
+type Greeting struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+	Locale  string `json:"locale"`
+}
+
+// SayHelloJSON returns the JSON encoding of the English Greeting for
+// name. Non-ASCII text and HTML-sensitive characters such as '<' are
+// written as-is rather than as \u escapes.
+func SayHelloJSON(name string) ([]byte, error) {
+	return marshalGreeting(Greeting{Name: name, Message: SayHello(name), Locale: "en"})
+}
+
+func marshalGreeting(g Greeting) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(g); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,33 @@
+package hello
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSayHelloJSON(t *testing.T) {
+	got, err := SayHelloJSON("World")
+	if err != nil {
+		t.Fatalf("SayHelloJSON(\"World\") returned error: %v", err)
+	}
+	if want := `{"name":"World","message":"Hello World!","locale":"en"}`; string(got) != want {
+		t.Errorf("SayHelloJSON(\"World\") is %s; want %s", got, want)
+	}
+}
+
+func TestSayHelloJSONUnescaped(t *testing.T) {
+	got, err := SayHelloJSON("<嗨>")
+	if err != nil {
+		t.Fatalf("SayHelloJSON(\"<嗨>\") returned error: %v", err)
+	}
+	if want := `{"name":"<嗨>","message":"Hello <嗨>!","locale":"en"}`; string(got) != want {
+		t.Errorf("SayHelloJSON(\"<嗨>\") is %s; want %s", got, want)
+	}
+	var g Greeting
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if want := (Greeting{Name: "<嗨>", Message: SayHello("<嗨>"), Locale: "en"}); g != want {
+		t.Errorf("decoded %+v; want %+v", g, want)
+	}
+}
@@ -0,0 +1,64 @@
+package hello
+
+import (
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// HelloLength returns the number of user-perceived characters (extended
+// grapheme clusters, per Unicode UAX #29) in SayHello(name). Combining
+// marks and ZWJ emoji sequences count as a single character, so the
+// result can be smaller than both the byte length and the rune count.
+func HelloLength(name string) int {
+	return uniseg.GraphemeClusterCount(SayHello(name))
+}
+
+// SayHelloMax greets name, first truncating it to at most maxRunes runes
+// and appending "…" if anything was cut. Truncation happens only at
+// grapheme cluster boundaries, so a cluster that would straddle the limit
+// is dropped whole rather than split. A negative maxRunes disables
+// truncation.
+func SayHelloMax(name string, maxRunes int) string {
+	if maxRunes < 0 || utf8.RuneCountInString(name) <= maxRunes {
+		return SayHello(name)
+	}
+	var end, runes int
+	state := -1
+	rest := name
+	for len(rest) > 0 {
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		runes += utf8.RuneCountInString(cluster)
+		if runes > maxRunes {
+			break
+		}
+		end += len(cluster)
+	}
+	return SayHello(name[:end] + "…")
+}
+
+// GreetingInfo describes the size of a greeting for layout decisions.
+type GreetingInfo struct {
+	Bytes     int  // length in bytes
+	Runes     int  // number of Unicode code points
+	Graphemes int  // number of user-perceived characters, as HelloLength
+	NonASCII  bool // whether the name contains any non-ASCII character
+}
+
+// SayHelloInfo returns SayHello(name) together with its GreetingInfo.
+func SayHelloInfo(name string) (message string, info GreetingInfo) {
+	message = SayHello(name)
+	info = GreetingInfo{
+		Bytes:     len(message),
+		Runes:     utf8.RuneCountInString(message),
+		Graphemes: uniseg.GraphemeClusterCount(message),
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] >= utf8.RuneSelf {
+			info.NonASCII = true
+			break
+		}
+	}
+	return message, info
+}
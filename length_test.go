@@ -0,0 +1,82 @@
+package hello
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestHelloLength(t *testing.T) {
+	tests := []struct {
+		name                    string
+		bytes, runes, graphemes int
+	}{
+		{"World", 12, 12, 12},
+		{"嗨", 10, 8, 8},
+		{"e\u0301", 10, 9, 8},             // e + combining acute
+		{"👨\u200d👩\u200d👧", 25, 12, 8},    // family ZWJ sequence
+		{"🇯🇵", 15, 9, 8},                  // regional indicator pair
+		{"\u1100\u1161\u11a8", 16, 10, 8}, // Hangul jamo for 각
+		{"👋\U0001F3FD", 15, 9, 8},         // emoji with skin tone modifier
+	}
+	for _, tt := range tests {
+		msg := SayHello(tt.name)
+		if n := len(msg); n != tt.bytes {
+			t.Errorf("len(SayHello(%q)) is %d; want %d", tt.name, n, tt.bytes)
+		}
+		if n := utf8.RuneCountInString(msg); n != tt.runes {
+			t.Errorf("utf8.RuneCountInString(SayHello(%q)) is %d; want %d", tt.name, n, tt.runes)
+		}
+		if n := HelloLength(tt.name); n != tt.graphemes {
+			t.Errorf("HelloLength(%q) is %d; want %d", tt.name, n, tt.graphemes)
+		}
+	}
+}
+
+func TestSayHelloMax(t *testing.T) {
+	tests := []struct {
+		name string
+		max  int
+		want string
+	}{
+		{"World", 10, "Hello World!"},
+		{"World", 5, "Hello World!"},
+		{"World", 4, "Hello Worl…!"},
+		{"World", 0, "Hello …!"},
+		{"World", -1, "Hello World!"},
+		{"嗨嗨嗨", 2, "Hello 嗨嗨…!"},
+		{"Hi 👨\u200d👩\u200d👧 there", 4, "Hello Hi …!"}, // family is 5 runes; dropped whole
+		{"Hi 👨\u200d👩\u200d👧 there", 8, "Hello Hi 👨\u200d👩\u200d👧…!"},
+		{"Rene\u0301e", 4, "Hello Ren…!"}, // e + U+0301 is kept together
+	}
+	for _, tt := range tests {
+		got := SayHelloMax(tt.name, tt.max)
+		if got != tt.want {
+			t.Errorf("SayHelloMax(%q, %d) is %q; want %q", tt.name, tt.max, got, tt.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("SayHelloMax(%q, %d) is not valid UTF-8", tt.name, tt.max)
+		}
+	}
+}
+
+func TestSayHelloInfo(t *testing.T) {
+	tests := []struct {
+		name string
+		want GreetingInfo
+	}{
+		{"World", GreetingInfo{Bytes: 12, Runes: 12, Graphemes: 12}},
+		{"", GreetingInfo{Bytes: 7, Runes: 7, Graphemes: 7}},
+		{"嗨", GreetingInfo{Bytes: 10, Runes: 8, Graphemes: 8, NonASCII: true}},
+		{"Ada 👨\u200d👩\u200d👧", GreetingInfo{Bytes: 29, Runes: 16, Graphemes: 12, NonASCII: true}},
+		{"Rene\u0301e", GreetingInfo{Bytes: 14, Runes: 13, Graphemes: 12, NonASCII: true}},
+	}
+	for _, tt := range tests {
+		msg, info := SayHelloInfo(tt.name)
+		if want := SayHello(tt.name); msg != want {
+			t.Errorf("SayHelloInfo(%q) message is %q; want %q", tt.name, msg, want)
+		}
+		if info != tt.want {
+			t.Errorf("SayHelloInfo(%q) info is %+v; want %+v", tt.name, info, tt.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package hello
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// locale describes how a language greets someone and bids them
+// farewell. When nameFirst is set the name precedes the greeting word, as
+// in Japanese.
+type locale struct {
+	hello     string
+	goodbye   string
+	sep       string
+	suffix    string
+	nameFirst bool
+}
+
+var locales = map[string]locale{
+	"en": {hello: Prefix, goodbye: "Goodbye", sep: Separator, suffix: Suffix},
+	"es": {hello: "¡Hola", goodbye: "¡Adiós", sep: ", ", suffix: "!"},
+	"fr": {hello: "Bonjour", goodbye: "Au revoir", sep: " ", suffix: " !"},
+	"de": {hello: "Hallo", goodbye: "Auf Wiedersehen", sep: " ", suffix: "!"},
+	"it": {hello: "Ciao", goodbye: "Arrivederci", sep: " ", suffix: "!"},
+	"pt": {hello: "Olá", goodbye: "Adeus", sep: " ", suffix: "!"},
+	"ja": {hello: "こんにちは", goodbye: "さようなら", sep: "さん、", suffix: "！", nameFirst: true},
+	"hu": {hello: "Szia", goodbye: "Viszlát", sep: " ", suffix: "!"},
+	"ru": {hello: "Привет", goodbye: "До свидания", sep: ", ", suffix: "!"},
+	"zh": {hello: "你好", goodbye: "再见", sep: "，", suffix: "！"},
+}
+
+func (l locale) greet(word, name string) string {
+	if l.nameFirst {
+		return name + l.sep + word + l.suffix
+	}
+	return word + l.sep + name + l.suffix
+}
+
+// lookupLocale finds the table entry for a BCP-47 tag, using only its
+// primary language subtag, so "en-US" and "EN" both resolve to "en".
+func lookupLocale(tag string) (locale, bool) {
+	l, ok := locales[baseLanguage(tag)]
+	return l, ok
+}
+
+// baseLanguage returns the lowercased primary language subtag of tag.
+func baseLanguage(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
+
+// SayHelloLocale greets name in the language of the BCP-47 tag locale,
+// e.g. SayHelloLocale("es", "Mundo") returns "¡Hola, Mundo!". Region and
+// script subtags are ignored. Unknown languages return ErrUnknownLocale.
+func SayHelloLocale(locale, name string) (string, error) {
+	l, ok := lookupLocale(locale)
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+	}
+	return l.greet(l.hello, name), nil
+}
+
+// SayGoodbyeLocale bids name farewell in the language of the BCP-47 tag
+// locale, using the same table and rules as SayHelloLocale.
+func SayGoodbyeLocale(locale, name string) (string, error) {
+	l, ok := lookupLocale(locale)
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+	}
+	return l.greet(l.goodbye, name), nil
+}
+
+// SayHelloAuto greets name in a language guessed from the scripts it is
+// written in: Japanese if it contains any Hiragana or Katakana, otherwise
+// Chinese if it contains Han ideographs, otherwise Russian if it contains
+// Cyrillic, and English for everything else. The guess is best-effort:
+// kana is checked first because Japanese names routinely mix kana with
+// Han, so a mixed Han and kana name is always treated as Japanese, and a
+// Japanese name written only in kanji is greeted in Chinese.
+func SayHelloAuto(name string) string {
+	l := locales[detectLocale(name)]
+	return l.greet(l.hello, name)
+}
+
+func detectLocale(name string) string {
+	var han, cyrillic bool
+	for _, r := range name {
+		switch {
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+			return "ja"
+		case unicode.Is(unicode.Han, r):
+			han = true
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic = true
+		}
+	}
+	switch {
+	case han:
+		return "zh"
+	case cyrillic:
+		return "ru"
+	default:
+		return "en"
+	}
+}
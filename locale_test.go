@@ -0,0 +1,105 @@
+package hello
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSayHelloLocale(t *testing.T) {
+	tests := []struct {
+		locale, name, want string
+	}{
+		{"en", "World", "Hello World!"},
+		{"es", "Mundo", "¡Hola, Mundo!"},
+		{"fr", "Monde", "Bonjour Monde !"},
+		{"de", "Welt", "Hallo Welt!"},
+		{"it", "Mondo", "Ciao Mondo!"},
+		{"pt", "Mundo", "Olá Mundo!"},
+		{"ja", "世界", "世界さん、こんにちは！"},
+		{"hu", "Világ", "Szia Világ!"},
+		{"ru", "Мир", "Привет, Мир!"},
+		{"zh", "世界", "你好，世界！"},
+		{"en-US", "World", "Hello World!"},
+		{"PT-br", "Mundo", "Olá Mundo!"},
+	}
+	for _, tt := range tests {
+		got, err := SayHelloLocale(tt.locale, tt.name)
+		if err != nil {
+			t.Errorf("SayHelloLocale(%q, %q) returned error: %v", tt.locale, tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("SayHelloLocale(%q, %q) is %q; want %q", tt.locale, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSayHelloLocaleEnglishMatchesSayHello(t *testing.T) {
+	got, _ := SayHelloLocale("en", "嗨")
+	if want := SayHello("嗨"); got != want {
+		t.Errorf("SayHelloLocale(\"en\", \"嗨\") is %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloLocaleUnknown(t *testing.T) {
+	for _, locale := range []string{"", "xx", "klingon"} {
+		_, err := SayHelloLocale(locale, "World")
+		if !errors.Is(err, ErrUnknownLocale) {
+			t.Errorf("SayHelloLocale(%q, \"World\") error is %v; want ErrUnknownLocale", locale, err)
+		}
+	}
+}
+
+func TestSayGoodbyeLocale(t *testing.T) {
+	tests := []struct {
+		locale, name, want string
+	}{
+		{"en", "World", "Goodbye World!"},
+		{"es", "Mundo", "¡Adiós, Mundo!"},
+		{"fr", "Monde", "Au revoir Monde !"},
+		{"de", "Welt", "Auf Wiedersehen Welt!"},
+		{"it", "Mondo", "Arrivederci Mondo!"},
+		{"pt", "Mundo", "Adeus Mundo!"},
+		{"ja", "世界", "世界さん、さようなら！"},
+		{"hu", "Világ", "Viszlát Világ!"},
+		{"ru", "Мир", "До свидания, Мир!"},
+		{"zh", "世界", "再见，世界！"},
+	}
+	for _, tt := range tests {
+		got, err := SayGoodbyeLocale(tt.locale, tt.name)
+		if err != nil {
+			t.Errorf("SayGoodbyeLocale(%q, %q) returned error: %v", tt.locale, tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("SayGoodbyeLocale(%q, %q) is %q; want %q", tt.locale, tt.name, got, tt.want)
+		}
+	}
+	if got, _ := SayGoodbyeLocale("en", "嗨"); got != SayGoodbye("嗨") {
+		t.Errorf("SayGoodbyeLocale(\"en\", \"嗨\") is %q; want %q", got, SayGoodbye("嗨"))
+	}
+	if _, err := SayGoodbyeLocale("xx", "World"); !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("SayGoodbyeLocale(\"xx\", \"World\") error is %v; want ErrUnknownLocale", err)
+	}
+}
+
+func TestSayHelloAuto(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"Анна", "Привет, Анна!"},
+		{"王伟", "你好，王伟！"},
+		{"さくら", "さくらさん、こんにちは！"},
+		{"カタカナ", "カタカナさん、こんにちは！"},
+		{"山田はな", "山田はなさん、こんにちは！"},
+		{"World", "Hello World!"},
+		{"José", "Hello José!"},
+		{"Ivan Иванов", "Привет, Ivan Иванов!"},
+		{"", "Hello !"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloAuto(tt.name); got != tt.want {
+			t.Errorf("SayHelloAuto(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package hello
+
+import "strings"
+
+// SayHelloFirstName greets only the first word of fullName, so "Ada
+// Lovelace" is greeted as "Hello Ada!". Words are separated by any
+// Unicode whitespace, and leading or repeated whitespace is ignored. A
+// fullName with no words at all is greeted unchanged.
+func SayHelloFirstName(fullName string) string {
+	words := strings.Fields(fullName)
+	if len(words) == 0 {
+		return SayHello(fullName)
+	}
+	return SayHello(words[0])
+}
+
+// SayHelloTitled greets name preceded by a title such as "Dr." or "Ms",
+// e.g. SayHelloTitled("Dr.", "Ada") returns "Hello Dr. Ada!". Surrounding
+// whitespace is trimmed from both so exactly one space separates them,
+// and the title is used as given: no period is added or removed. An empty
+// title greets name alone, and an empty name greets the title alone.
+func SayHelloTitled(title, name string) string {
+	title, name = strings.TrimSpace(title), strings.TrimSpace(name)
+	if title == "" {
+		return SayHello(name)
+	}
+	if name == "" {
+		return SayHello(title)
+	}
+	return SayHello(title + " " + name)
+}
+
+// familyFirst says how locales whose convention is not to address people
+// by their given name form the name used in a greeting.
+var familyFirst = map[string]func(given, family string) string{
+	"ja": func(given, family string) string { return family },
+	"zh": func(given, family string) string { return family + given },
+	"hu": func(given, family string) string { return family + " " + given },
+}
+
+// SayHelloName greets a person in the language of locale, addressing them
+// by the part or order of their name that the locale's convention calls
+// for:
+//
+//	ja      family name only, with the locale's honorific: 山田さん
+//	zh      family name then given name, unspaced: 王伟
+//	hu      family name then given name: Kovács Anna
+//	others  given name only: Ada
+//
+// Unknown locales are greeted in English using the given name. If either
+// part is empty the other is used alone.
+func SayHelloName(given, family, locale string) string {
+	l, ok := lookupLocale(locale)
+	if !ok {
+		l = locales["en"]
+	}
+	name := given
+	switch {
+	case given == "":
+		name = family
+	case family == "":
+	default:
+		if f, ok := familyFirst[baseLanguage(locale)]; ok {
+			name = f(given, family)
+		}
+	}
+	return l.greet(l.hello, name)
+}
@@ -0,0 +1,66 @@
+package hello
+
+import "testing"
+
+func TestSayHelloFirstName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"Ada", "Hello Ada!"},
+		{"Ada Lovelace", "Hello Ada!"},
+		{"  Ada   King  Lovelace ", "Hello Ada!"},
+		{"\tGrace\nHopper", "Hello Grace!"},
+		{"José\u00a0García", "Hello José!"},
+		{"Фёдор Достоевский", "Hello Фёдор!"},
+		{"山田\u3000太郎", "Hello 山田!"},
+		{"", "Hello !"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloFirstName(tt.name); got != tt.want {
+			t.Errorf("SayHelloFirstName(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSayHelloTitled(t *testing.T) {
+	tests := []struct {
+		title, name, want string
+	}{
+		{"", "Ada", "Hello Ada!"},
+		{"  ", "Ada", "Hello Ada!"},
+		{"Dr.", "Ada", "Hello Dr. Ada!"},
+		{"Dr. ", " Ada", "Hello Dr. Ada!"},
+		{"Dr.", "", "Hello Dr.!"},
+		{"Dr.", "  ", "Hello Dr.!"},
+		{"Ms", "Ada", "Hello Ms Ada!"},
+		{"Sr.", "José", "Hello Sr. José!"},
+		{"Д-р", "Иванов", "Hello Д-р Иванов!"},
+		{"博士", "王伟", "Hello 博士 王伟!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloTitled(tt.title, tt.name); got != tt.want {
+			t.Errorf("SayHelloTitled(%q, %q) is %q; want %q", tt.title, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSayHelloName(t *testing.T) {
+	tests := []struct {
+		given, family, locale, want string
+	}{
+		{"Ada", "Lovelace", "en", "Hello Ada!"},
+		{"Ada", "Lovelace", "en-GB", "Hello Ada!"},
+		{"Pablo", "Picasso", "es", "¡Hola, Pablo!"},
+		{"太郎", "山田", "ja", "山田さん、こんにちは！"},
+		{"伟", "王", "zh", "你好，王伟！"},
+		{"Anna", "Kovács", "hu", "Szia Kovács Anna!"},
+		{"Ada", "Lovelace", "xx", "Hello Ada!"},
+		{"", "Lovelace", "en", "Hello Lovelace!"},
+		{"太郎", "", "ja", "太郎さん、こんにちは！"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloName(tt.given, tt.family, tt.locale); got != tt.want {
+			t.Errorf("SayHelloName(%q, %q, %q) is %q; want %q", tt.given, tt.family, tt.locale, got, tt.want)
+		}
+	}
+}
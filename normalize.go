@@ -0,0 +1,16 @@
+package hello
+
+// SayHelloNormalized greets name after converting it to Unicode
+// Normalization Form C, so canonically equivalent spellings of a name
+// (such as "é" and "e" followed by U+0301) produce identical greetings.
+func SayHelloNormalized(name string) string {
+	return SayHello(Normalize(name))
+}
+
+// SayHelloTrimmed greets name after removing leading and trailing
+// whitespace and collapsing each interior run of whitespace to a single
+// ASCII space. Whitespace is anything unicode.IsSpace reports, which
+// includes the no-break space U+00A0 and the ideographic space U+3000.
+func SayHelloTrimmed(name string) string {
+	return SayHello(Trim(name))
+}
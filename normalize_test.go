@@ -0,0 +1,42 @@
+package hello
+
+import "testing"
+
+func TestSayHelloNormalized(t *testing.T) {
+	tests := []struct {
+		composed, decomposed string
+	}{
+		{"Jos\u00e9", "Jose\u0301"},
+		{"Ren\u00e9e", "Rene\u0301e"},
+		{"\u00c5sa", "A\u030asa"},
+		{"\uac01", "\u1100\u1161\u11a8"},
+	}
+	for _, tt := range tests {
+		want := SayHello(tt.composed)
+		if got := SayHelloNormalized(tt.decomposed); got != want {
+			t.Errorf("SayHelloNormalized(%q) is %q; want %q", tt.decomposed, got, want)
+		}
+		if got := SayHelloNormalized(tt.composed); got != want {
+			t.Errorf("SayHelloNormalized(%q) is %q; want %q", tt.composed, got, want)
+		}
+	}
+}
+
+func TestSayHelloTrimmed(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"World", "Hello World!"},
+		{"  World  ", "Hello World!"},
+		{"\tAda\t\tLovelace\n", "Hello Ada Lovelace!"},
+		{"Ada    Lovelace", "Hello Ada Lovelace!"},
+		{"\u3000\u3000山田 太郎\u3000", "Hello 山田 太郎!"},
+		{"Ada\u00a0Lovelace\u00a0", "Hello Ada Lovelace!"},
+		{" \t ", "Hello !"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloTrimmed(tt.name); got != tt.want {
+			t.Errorf("SayHelloTrimmed(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package hello
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseHello is the inverse of SayHello: it returns the name in a
+// greeting of the form "Hello <name>!". Everything between Prefix and
+// Separator and the final Suffix is the name, so names containing
+// commas, spaces or exclamation marks round-trip unchanged.
+func ParseHello(greeting string) (string, error) {
+	const prefix = Prefix + Separator
+	if len(greeting) < len(prefix)+len(Suffix) ||
+		!strings.HasPrefix(greeting, prefix) || !strings.HasSuffix(greeting, Suffix) {
+		return "", fmt.Errorf("%w: %q", ErrMalformedGreeting, greeting)
+	}
+	return greeting[len(prefix) : len(greeting)-len(Suffix)], nil
+}
@@ -0,0 +1,27 @@
+package hello
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseHello(t *testing.T) {
+	for _, name := range []string{"World", "嗨", "Lovelace, Ada", "Wow!", ""} {
+		got, err := ParseHello(SayHello(name))
+		if err != nil {
+			t.Errorf("ParseHello(%q) returned error: %v", SayHello(name), err)
+			continue
+		}
+		if got != name {
+			t.Errorf("ParseHello(%q) is %q; want %q", SayHello(name), got, name)
+		}
+	}
+}
+
+func TestParseHelloMalformed(t *testing.T) {
+	for _, s := range []string{"", "Hello", "Hello World", "Hi World!", "hello World!", "HelloWorld!", "Goodbye World!"} {
+		if got, err := ParseHello(s); !errors.Is(err, ErrMalformedGreeting) {
+			t.Errorf("ParseHello(%q) is %q, %v; want ErrMalformedGreeting", s, got, err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package hello
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// A Transform rewrites a name before it is greeted.
+type Transform func(string) string
+
+// SayHelloPipe greets name after passing it through each of transforms
+// from left to right. With no transforms it is SayHello.
+func SayHelloPipe(name string, transforms ...Transform) string {
+	for _, t := range transforms {
+		name = t(name)
+	}
+	return SayHello(name)
+}
+
+// Trim removes leading and trailing whitespace from name and collapses
+// each interior run of whitespace to a single ASCII space.
+func Trim(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// TitleCase title-cases name with language-neutral rules, so "wORLD"
+// becomes "World". Use WithTitleCase for language-specific rules.
+func TitleCase(name string) string {
+	return cases.Title(language.Und).String(name)
+}
+
+// Normalize converts name to Unicode Normalization Form C.
+func Normalize(name string) string {
+	return norm.NFC.String(name)
+}
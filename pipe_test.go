@@ -0,0 +1,34 @@
+package hello
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSayHelloPipeEmpty(t *testing.T) {
+	for _, name := range []string{"World", " wORLD ", "嗨", ""} {
+		if got, want := SayHelloPipe(name), SayHello(name); got != want {
+			t.Errorf("SayHelloPipe(%q) is %q; want %q", name, got, want)
+		}
+	}
+}
+
+func TestSayHelloPipe(t *testing.T) {
+	tests := []struct {
+		name       string
+		transforms []Transform
+		want       string
+	}{
+		{"  ada   lovelace ", []Transform{Trim, TitleCase}, "Hello Ada Lovelace!"},
+		{"Jose\u0301", []Transform{Normalize}, "Hello Jos\u00e9!"},
+		{"wORLD", []Transform{TitleCase, strings.ToUpper}, "Hello WORLD!"},
+		{"wORLD", []Transform{strings.ToUpper, TitleCase}, "Hello World!"},
+		{" Ada ", []Transform{Trim, func(s string) string { return s + " " }}, "Hello Ada !"},
+		{" Ada ", []Transform{func(s string) string { return s + " " }, Trim}, "Hello Ada!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloPipe(tt.name, tt.transforms...); got != tt.want {
+			t.Errorf("SayHelloPipe(%q, ...) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
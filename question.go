@@ -0,0 +1,23 @@
+package hello
+
+import "strings"
+
+// DefaultQuestion is the follow-up SayHelloQuestion asks.
+const DefaultQuestion = "How are you?"
+
+// SayHelloQuestion greets name and asks DefaultQuestion, e.g. "Hello
+// World! How are you?".
+func SayHelloQuestion(name string) string {
+	return SayHelloQuestionWith(name, DefaultQuestion)
+}
+
+// SayHelloQuestionWith is like SayHelloQuestion but asks question
+// instead. Surrounding whitespace is trimmed from question so exactly one
+// space follows the greeting; an empty question leaves just the greeting.
+func SayHelloQuestionWith(name, question string) string {
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return SayHello(name)
+	}
+	return SayHello(name) + " " + question
+}
@@ -0,0 +1,28 @@
+package hello
+
+import "testing"
+
+func TestSayHelloQuestion(t *testing.T) {
+	if got, want := SayHelloQuestion("World"), "Hello World! How are you?"; got != want {
+		t.Errorf("SayHelloQuestion(\"World\") is %q; want %q", got, want)
+	}
+	if got, want := SayHelloQuestion("嗨"), "Hello 嗨! How are you?"; got != want {
+		t.Errorf("SayHelloQuestion(\"嗨\") is %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloQuestionWith(t *testing.T) {
+	tests := []struct {
+		question, want string
+	}{
+		{"¿Qué tal?", "Hello Mundo! ¿Qué tal?"},
+		{"お元気ですか？", "Hello Mundo! お元気ですか？"},
+		{"  Как дела?\n", "Hello Mundo! Как дела?"},
+		{"", "Hello Mundo!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloQuestionWith("Mundo", tt.question); got != tt.want {
+			t.Errorf("SayHelloQuestionWith(\"Mundo\", %q) is %q; want %q", tt.question, got, tt.want)
+		}
+	}
+}
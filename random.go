@@ -0,0 +1,13 @@
+package hello
+
+import "math/rand"
+
+var friendlyGreetings = [...]string{Prefix, "Hi there", "Hey", "Greetings"}
+
+// SayHelloRandom greets name with a greeting word picked by r from
+// "Hello", "Hi there", "Hey" and "Greetings". Each call consumes one value
+// from r, so a rand.Rand created from a fixed seed yields the same
+// sequence of greetings every time.
+func SayHelloRandom(r *rand.Rand, name string) string {
+	return SayHelloWith(friendlyGreetings[r.Intn(len(friendlyGreetings))], name)
+}
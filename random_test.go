@@ -0,0 +1,41 @@
+package hello
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSayHelloRandomSeeded(t *testing.T) {
+	want := []string{
+		"Hi there World!",
+		"Greetings World!",
+		"Hello World!",
+		"Hey World!",
+		"Greetings World!",
+		"Hi there World!",
+	}
+	for run := 0; run < 2; run++ {
+		r := rand.New(rand.NewSource(42))
+		got := make([]string, len(want))
+		for i := range got {
+			got[i] = SayHelloRandom(r, "World")
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("SayHelloRandom with seed 42 produced %q; want %q", got, want)
+		}
+	}
+}
+
+func TestSayHelloRandomReachesAll(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	seen := make(map[string]bool)
+	for i := 0; i < 1000 && len(seen) < len(friendlyGreetings); i++ {
+		seen[SayHelloRandom(r, "嗨")] = true
+	}
+	for _, word := range friendlyGreetings {
+		if msg := SayHelloWith(word, "嗨"); !seen[msg] {
+			t.Errorf("SayHelloRandom never produced %q", msg)
+		}
+	}
+}
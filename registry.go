@@ -0,0 +1,36 @@
+package hello
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	variantsMu sync.RWMutex
+	variants   = make(map[string]func(string) string)
+)
+
+// Register makes the greeting function fn available to SayHelloVariant
+// under the key variant, replacing any function previously registered
+// under the same key. It is safe to call concurrently with
+// SayHelloVariant. Register panics if fn is nil.
+func Register(variant string, fn func(string) string) {
+	if fn == nil {
+		panic("hello: Register function is nil")
+	}
+	variantsMu.Lock()
+	defer variantsMu.Unlock()
+	variants[variant] = fn
+}
+
+// SayHelloVariant greets name with the function registered under
+// variant, or returns ErrUnknownVariant if there is none.
+func SayHelloVariant(variant, name string) (string, error) {
+	variantsMu.RLock()
+	fn, ok := variants[variant]
+	variantsMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownVariant, variant)
+	}
+	return fn(name), nil
+}
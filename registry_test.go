@@ -0,0 +1,58 @@
+package hello
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	Register("test-pirate", func(name string) string { return "Ahoy " + name + "!" })
+	got, err := SayHelloVariant("test-pirate", "嗨")
+	if err != nil {
+		t.Fatalf("SayHelloVariant(\"test-pirate\", \"嗨\") returned error: %v", err)
+	}
+	if want := "Ahoy 嗨!"; got != want {
+		t.Errorf("SayHelloVariant(\"test-pirate\", \"嗨\") is %q; want %q", got, want)
+	}
+
+	Register("test-pirate", SayGoodbye)
+	if got, _ := SayHelloVariant("test-pirate", "World"); got != "Goodbye World!" {
+		t.Errorf("SayHelloVariant after re-Register is %q; want %q", got, "Goodbye World!")
+	}
+}
+
+func TestSayHelloVariantUnknown(t *testing.T) {
+	if got, err := SayHelloVariant("test-missing", "World"); !errors.Is(err, ErrUnknownVariant) {
+		t.Errorf("SayHelloVariant(\"test-missing\", \"World\") is %q, %v; want ErrUnknownVariant", got, err)
+	}
+}
+
+func TestRegisterNil(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Register with nil function did not panic")
+		}
+	}()
+	Register("test-nil", nil)
+}
+
+func TestRegisterConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		variant := "test-race-" + strconv.Itoa(i%4)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			Register(variant, SayHello)
+		}()
+		go func() {
+			defer wg.Done()
+			if got, err := SayHelloVariant(variant, "World"); err == nil && got != "Hello World!" {
+				t.Errorf("SayHelloVariant(%q, \"World\") is %q; want %q", variant, got, "Hello World!")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,29 @@
+package hello
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SayHelloSafe greets name after stripping every control (Cc), format
+// (Cf), line separator (Zl) and paragraph separator (Zp) character from
+// it, such as newlines, NUL bytes, U+2028 and bidirectional overrides,
+// so that untrusted input cannot break lines in logs or reorder
+// surrounding text. Three kinds of format character are kept
+// because legitimate text depends on them: the zero width joiner U+200D
+// used in emoji sequences, the zero width non-joiner U+200C used in
+// Persian and Indic spelling, and the tag characters U+E0020–U+E007F
+// used in subdivision flags such as England's. All other text is left
+// intact.
+func SayHelloSafe(name string) string {
+	return SayHello(strings.Map(func(r rune) rune {
+		if unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp) && !keepFormat(r) {
+			return -1
+		}
+		return r
+	}, name))
+}
+
+func keepFormat(r rune) bool {
+	return r == '\u200c' || r == '\u200d' || r >= '\U000e0020' && r <= '\U000e007f'
+}
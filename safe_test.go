@@ -0,0 +1,30 @@
+package hello
+
+import "testing"
+
+func TestSayHelloSafe(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"World", "Hello World!"},
+		{"World\nINFO forged log line", "Hello WorldINFO forged log line!"},
+		{"Wor\x00ld", "Hello World!"},
+		{"World\u2028INFO forged", "Hello WorldINFO forged!"}, // line separator
+		{"World\u2029INFO forged", "Hello WorldINFO forged!"}, // paragraph separator
+		{"\tWorld\r\n", "Hello World!"},
+		{"\u202eWorld\u202c", "Hello World!"}, // right-to-left override
+		{"Wor\u200bld", "Hello World!"},       // zero width space
+		{"👩\u200d💻", "Hello 👩\u200d💻!"},       // ZWJ sequence survives
+		{"🏳\ufe0f\u200d🌈", "Hello 🏳\ufe0f\u200d🌈!"},
+		{"嗨 José", "Hello 嗨 José!"},
+		// England flag: black flag, tags "gbeng", cancel tag
+		{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "Hello 🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f!"},
+		{"می\u200cخواهم", "Hello می\u200cخواهم!"}, // Persian word with ZWNJ
+		{"\ufeffWorld\u2066", "Hello World!"},     // BOM and bidi isolate
+	}
+	for _, tt := range tests {
+		if got := SayHelloSafe(tt.name); got != tt.want {
+			t.Errorf("SayHelloSafe(%q) is %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package hello
+
+import "runtime"
+
+// GreetStream greets each name received from in and sends the greetings
+// on the returned channel in the order the names arrived. Up to
+// GOMAXPROCS names are greeted concurrently. The returned channel is
+// closed once in is closed and every greeting has been delivered; the
+// caller must drain it to let GreetStream finish.
+func GreetStream(in <-chan string) <-chan string {
+	pending := make(chan chan string, runtime.GOMAXPROCS(0))
+	out := make(chan string)
+	go func() {
+		defer close(pending)
+		for name := range in {
+			c := make(chan string, 1)
+			pending <- c
+			go func(name string) { c <- SayHello(name) }(name)
+		}
+	}()
+	go func() {
+		defer close(out)
+		for c := range pending {
+			out <- <-c
+		}
+	}()
+	return out
+}
@@ -0,0 +1,47 @@
+package hello
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGreetStream(t *testing.T) {
+	names := []string{"Alice", "嗨", "José", "世界", ""}
+	for i := 0; i < 100; i++ {
+		names = append(names, "name"+strconv.Itoa(i))
+	}
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, name := range names {
+			in <- name
+		}
+	}()
+	var got []string
+	for msg := range GreetStream(in) {
+		got = append(got, msg)
+	}
+	if want := SayHelloAll(names); !reflect.DeepEqual(got, want) {
+		t.Errorf("GreetStream produced %q; want %q", got, want)
+	}
+}
+
+func TestGreetStreamCloses(t *testing.T) {
+	in := make(chan string)
+	out := GreetStream(in)
+	in <- "World"
+	if got := <-out; got != "Hello World!" {
+		t.Errorf("GreetStream produced %q; want %q", got, "Hello World!")
+	}
+	close(in)
+	select {
+	case msg, ok := <-out:
+		if ok {
+			t.Errorf("GreetStream produced %q after input closed; want closed channel", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("GreetStream output not closed after input closed")
+	}
+}
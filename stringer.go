@@ -0,0 +1,16 @@
+package hello
+
+import "fmt"
+
+// SayHelloStringer greets the value v by its String method. A nil v, or
+// a nil pointer whose String method panics, is greeted as "<nil>".
+func SayHelloStringer(v fmt.Stringer) string {
+	return SayHelloAny(v)
+}
+
+// SayHelloAny greets the value v as formatted by fmt.Sprint, so types
+// implementing fmt.Stringer or error are greeted by their own text and
+// everything else by its default format.
+func SayHelloAny(v any) string {
+	return SayHello(fmt.Sprint(v))
+}
@@ -0,0 +1,53 @@
+package hello
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type userID int
+
+func (id userID) String() string { return "user#" + strconv.Itoa(int(id)) }
+
+type person struct{ first, last string }
+
+func (p *person) String() string { return p.first + " " + p.last }
+
+func TestSayHelloStringer(t *testing.T) {
+	if got, want := SayHelloStringer(userID(42)), "Hello user#42!"; got != want {
+		t.Errorf("SayHelloStringer(userID(42)) is %q; want %q", got, want)
+	}
+	if got, want := SayHelloStringer(&person{"Ada", "Lovelace"}), "Hello Ada Lovelace!"; got != want {
+		t.Errorf("SayHelloStringer(&person{...}) is %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloStringerNil(t *testing.T) {
+	if got, want := SayHelloStringer(nil), "Hello <nil>!"; got != want {
+		t.Errorf("SayHelloStringer(nil) is %q; want %q", got, want)
+	}
+	var p *person
+	if got, want := SayHelloStringer(p), "Hello <nil>!"; got != want {
+		t.Errorf("SayHelloStringer((*person)(nil)) is %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloAny(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{42, "Hello 42!"},
+		{"嗨", "Hello 嗨!"},
+		{3.5, "Hello 3.5!"},
+		{userID(7), "Hello user#7!"},
+		{errors.New("oops"), "Hello oops!"},
+		{nil, "Hello <nil>!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloAny(tt.v); got != tt.want {
+			t.Errorf("SayHelloAny(%#v) is %q; want %q", tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package hello
+
+import (
+	"strings"
+	"text/template"
+)
+
+// TemplateData is the value greeting templates are executed with.
+type TemplateData struct {
+	Greeting string
+	Name     string
+}
+
+// HelloTemplate renders the same greeting as SayHello when executed with
+// TemplateData{Greeting: Prefix, Name: name}.
+var HelloTemplate = template.Must(template.New("hello").Parse("{{.Greeting}}" + Separator + "{{.Name}}" + Suffix))
+
+// SayHelloTemplate parses tmpl as a text/template and executes it with a
+// TemplateData for name, e.g. SayHelloTemplate("{{.Greeting}}, dear
+// {{.Name}}.", "World") returns "Hello, dear World.". Parse and execution
+// errors, such as a reference to an unknown field, match ErrBadTemplate
+// and wrap the underlying text/template error.
+func SayHelloTemplate(tmpl string, name string) (string, error) {
+	t, err := template.New("greeting").Parse(tmpl)
+	if err != nil {
+		return "", &templateError{err}
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, TemplateData{Greeting: Prefix, Name: name}); err != nil {
+		return "", &templateError{err}
+	}
+	return b.String(), nil
+}
+
+// templateError reports a template failure as ErrBadTemplate while
+// keeping the original error available to errors.As.
+type templateError struct {
+	err error
+}
+
+func (e *templateError) Error() string { return ErrBadTemplate.Error() + ": " + e.err.Error() }
+
+func (e *templateError) Is(target error) bool { return target == ErrBadTemplate }
+
+func (e *templateError) Unwrap() error { return e.err }
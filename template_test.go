@@ -0,0 +1,48 @@
+package hello
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHelloTemplate(t *testing.T) {
+	var b strings.Builder
+	if err := HelloTemplate.Execute(&b, TemplateData{Greeting: "Hello", Name: "World"}); err != nil {
+		t.Fatalf("HelloTemplate.Execute returned error: %v", err)
+	}
+	if got, want := b.String(), SayHello("World"); got != want {
+		t.Errorf("HelloTemplate rendered %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloTemplate(t *testing.T) {
+	got, err := SayHelloTemplate("{{.Greeting}}, dear {{.Name}}.", "World")
+	if err != nil {
+		t.Fatalf("SayHelloTemplate returned error: %v", err)
+	}
+	if want := "Hello, dear World."; got != want {
+		t.Errorf("SayHelloTemplate is %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloTemplateUTF(t *testing.T) {
+	got, err := SayHelloTemplate("{{.Name}}さん、{{.Greeting}}！", "嗨")
+	if err != nil {
+		t.Fatalf("SayHelloTemplate returned error: %v", err)
+	}
+	if want := "嗨さん、Hello！"; got != want {
+		t.Errorf("SayHelloTemplate is %q; want %q", got, want)
+	}
+}
+
+func TestSayHelloTemplateErrors(t *testing.T) {
+	for _, tmpl := range []string{
+		"{{.Greeting}} {{.Surname}}!", // unknown field
+		"{{.Greeting",                 // unterminated action
+		"{{template \"missing\"}}",    // undefined template
+	} {
+		if got, err := SayHelloTemplate(tmpl, "World"); err == nil {
+			t.Errorf("SayHelloTemplate(%q) is %q; want error", tmpl, got)
+		}
+	}
+}
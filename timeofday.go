@@ -0,0 +1,27 @@
+package hello
+
+import "time"
+
+// SayHelloAt greets name with a phrase chosen from the hour of t in t's
+// own location:
+//
+//	05:00–11:59  Good morning
+//	12:00–17:59  Good afternoon
+//	18:00–21:59  Good evening
+//	22:00–04:59  Good night
+func SayHelloAt(t time.Time, name string) string {
+	return SayHelloWith(timeOfDay(t.Hour()), name)
+}
+
+func timeOfDay(hour int) string {
+	switch {
+	case hour >= 5 && hour < 12:
+		return "Good morning"
+	case hour >= 12 && hour < 18:
+		return "Good afternoon"
+	case hour >= 18 && hour < 22:
+		return "Good evening"
+	default:
+		return "Good night"
+	}
+}
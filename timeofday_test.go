@@ -0,0 +1,37 @@
+package hello
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSayHelloAt(t *testing.T) {
+	tests := []struct {
+		hour, min int
+		want      string
+	}{
+		{0, 0, "Good night World!"},
+		{4, 59, "Good night World!"},
+		{5, 0, "Good morning World!"},
+		{11, 59, "Good morning World!"},
+		{12, 0, "Good afternoon World!"},
+		{17, 59, "Good afternoon World!"},
+		{18, 0, "Good evening World!"},
+		{21, 59, "Good evening World!"},
+		{22, 0, "Good night World!"},
+		{23, 59, "Good night World!"},
+	}
+	for _, tt := range tests {
+		at := time.Date(2022, time.November, 1, tt.hour, tt.min, 0, 0, time.UTC)
+		if got := SayHelloAt(at, "World"); got != tt.want {
+			t.Errorf("SayHelloAt(%s, \"World\") is %q; want %q", at.Format("15:04"), got, tt.want)
+		}
+	}
+}
+
+func TestSayHelloAtUsesLocation(t *testing.T) {
+	at := time.Date(2022, time.November, 1, 9, 0, 0, 0, time.FixedZone("UTC+9", 9*60*60))
+	if got, want := SayHelloAt(at, "世界"), "Good morning 世界!"; got != want {
+		t.Errorf("SayHelloAt(09:00 UTC+9, \"世界\") is %q; want %q", got, want)
+	}
+}
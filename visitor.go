@@ -0,0 +1,35 @@
+package hello
+
+import "strconv"
+
+// SayHelloVisitor greets name and tells them their visitor number, with
+// commas between groups of thousands: SayHelloVisitor("World", 1000)
+// returns "Hello World! You are visitor #1,000.". The number is written
+// as a plain "#N" without an ordinal suffix. A zero or negative n is not
+// a valid visitor number, so only the greeting is returned.
+func SayHelloVisitor(name string, n int) string {
+	if n <= 0 {
+		return SayHello(name)
+	}
+	return SayHello(name) + " You are visitor #" + groupThousands(n) + "."
+}
+
+// groupThousands formats the non-negative n with commas between groups
+// of three digits.
+func groupThousands(n int) string {
+	s := strconv.Itoa(n)
+	if len(s) <= 3 {
+		return s
+	}
+	head := len(s) % 3
+	if head == 0 {
+		head = 3
+	}
+	b := make([]byte, 0, len(s)+(len(s)-1)/3)
+	b = append(b, s[:head]...)
+	for i := head; i < len(s); i += 3 {
+		b = append(b, ',')
+		b = append(b, s[i:i+3]...)
+	}
+	return string(b)
+}
@@ -0,0 +1,24 @@
+package hello
+
+import "testing"
+
+func TestSayHelloVisitor(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{1, "Hello World! You are visitor #1."},
+		{42, "Hello World! You are visitor #42."},
+		{999, "Hello World! You are visitor #999."},
+		{1000, "Hello World! You are visitor #1,000."},
+		{123456, "Hello World! You are visitor #123,456."},
+		{1234567, "Hello World! You are visitor #1,234,567."},
+		{0, "Hello World!"},
+		{-5, "Hello World!"},
+	}
+	for _, tt := range tests {
+		if got := SayHelloVisitor("World", tt.n); got != tt.want {
+			t.Errorf("SayHelloVisitor(\"World\", %d) is %q; want %q", tt.n, got, tt.want)
+		}
+	}
+}
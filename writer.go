@@ -0,0 +1,33 @@
+package hello
+
+import (
+	"io"
+	"strings"
+)
+
+// WriteHello writes the same bytes as SayHello(name) to w without
+// building the greeting string first. It returns the number of bytes
+// written and the first write error encountered, if any.
+func WriteHello(w io.Writer, name string) (int, error) {
+	var total int
+	for _, s := range [...]string{Prefix, Separator, name, Suffix} {
+		n, err := io.WriteString(w, s)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// NewHelloReader returns a reader that yields the same bytes as
+// SayHello(name). The greeting is produced piece by piece as it is read
+// and never assembled into a single string.
+func NewHelloReader(name string) io.Reader {
+	return io.MultiReader(
+		strings.NewReader(Prefix),
+		strings.NewReader(Separator),
+		strings.NewReader(name),
+		strings.NewReader(Suffix),
+	)
+}
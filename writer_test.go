@@ -0,0 +1,84 @@
+package hello
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestWriteHello(t *testing.T) {
+	for _, name := range []string{"World", "嗨", ""} {
+		var buf bytes.Buffer
+		n, err := WriteHello(&buf, name)
+		if err != nil {
+			t.Fatalf("WriteHello(%q) returned error: %v", name, err)
+		}
+		want := SayHello(name)
+		if buf.String() != want {
+			t.Errorf("WriteHello(%q) wrote %q; want %q", name, buf.String(), want)
+		}
+		if n != len(want) {
+			t.Errorf("WriteHello(%q) returned %d; want %d", name, n, len(want))
+		}
+	}
+}
+
+var errShortWrite = errors.New("short write")
+
+// limitedWriter accepts up to limit bytes and then fails.
+type limitedWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if room := w.limit - w.buf.Len(); len(p) > room {
+		w.buf.Write(p[:room])
+		return room, errShortWrite
+	}
+	return w.buf.Write(p)
+}
+
+func TestWriteHelloError(t *testing.T) {
+	w := &limitedWriter{limit: 8}
+	n, err := WriteHello(w, "World")
+	if err != errShortWrite {
+		t.Errorf("WriteHello error is %v; want %v", err, errShortWrite)
+	}
+	if n != 8 {
+		t.Errorf("WriteHello returned %d; want 8", n)
+	}
+	if got := w.buf.String(); got != "Hello Wo" {
+		t.Errorf("WriteHello wrote %q; want %q", got, "Hello Wo")
+	}
+}
+
+func TestNewHelloReader(t *testing.T) {
+	for _, name := range []string{"World", "嗨", ""} {
+		got, err := io.ReadAll(NewHelloReader(name))
+		if err != nil {
+			t.Fatalf("io.ReadAll(NewHelloReader(%q)) returned error: %v", name, err)
+		}
+		if want := SayHello(name); string(got) != want {
+			t.Errorf("NewHelloReader(%q) read %q; want %q", name, got, want)
+		}
+	}
+}
+
+func TestNewHelloReaderChunks(t *testing.T) {
+	for _, name := range []string{"嗨世界", "👨\u200d👩\u200d👧", "Jose\u0301"} {
+		want := SayHello(name)
+		got, err := io.ReadAll(iotest.OneByteReader(NewHelloReader(name)))
+		if err != nil {
+			t.Fatalf("reading NewHelloReader(%q) a byte at a time returned error: %v", name, err)
+		}
+		if string(got) != want {
+			t.Errorf("NewHelloReader(%q) read a byte at a time is %q; want %q", name, got, want)
+		}
+		if err := iotest.TestReader(NewHelloReader(name), []byte(want)); err != nil {
+			t.Errorf("iotest.TestReader(NewHelloReader(%q)): %v", name, err)
+		}
+	}
+}